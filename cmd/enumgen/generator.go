@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const generatedMarker = "// Code generated by enumgen"
+
+type variant struct {
+	Const string
+	Name  string
+}
+
+type enumSpec struct {
+	Package    string
+	Type       string
+	Underlying string
+	IsString   bool
+	Variants   []variant
+	Args       string
+}
+
+// generate type-checks the package in dir and renders the enum code for typeName.
+// Files previously written by enumgen are skipped so stale output never affects the result.
+func generate(dir, typeName, trimPrefix string) ([]byte, error) {
+	fset := token.NewFileSet()
+	files, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	// code that already uses the generated identifiers cannot type-check until they
+	// exist, so only those errors are tolerated
+	typeErrs := []error{}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if terr, ok := err.(types.Error); ok && refersToGenerated(terr.Msg, typeName) {
+				return
+			}
+			typeErrs = append(typeErrs, err)
+		},
+	}
+	pkg, _ := conf.Check(files[0].Name.Name, fset, files, nil)
+	if len(typeErrs) > 0 {
+		return nil, fmt.Errorf("type-checking %s: %w", dir, errors.Join(typeErrs...))
+	}
+
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in %s", typeName, dir)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a named type", typeName)
+	}
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return nil, fmt.Errorf("%s must have an integer or string underlying type", typeName)
+	}
+
+	consts := []*types.Const{}
+	for _, name := range pkg.Scope().Names() {
+		if c, ok := pkg.Scope().Lookup(name).(*types.Const); ok && types.Identical(c.Type(), named) {
+			consts = append(consts, c)
+		}
+	}
+	if len(consts) == 0 {
+		return nil, fmt.Errorf("no constants of type %s in %s", typeName, dir)
+	}
+	slices.SortFunc(consts, func(a, b *types.Const) int { return int(a.Pos() - b.Pos()) })
+
+	spec := enumSpec{
+		Package:    pkg.Name(),
+		Type:       typeName,
+		Underlying: basic.Name(),
+		IsString:   basic.Info()&types.IsString != 0,
+		Args:       buildArgs(typeName, trimPrefix),
+	}
+	seen := []constant.Value{}
+	names := map[string]string{}
+	for _, c := range consts {
+		// aliases such as StatusDefault = StatusActive would produce duplicate cases
+		if slices.ContainsFunc(seen, func(v constant.Value) bool { return constant.Compare(v, token.EQL, c.Val()) }) {
+			continue
+		}
+		seen = append(seen, c.Val())
+
+		name := strings.TrimPrefix(c.Name(), trimPrefix)
+		if name == "" {
+			return nil, fmt.Errorf("constant %s is empty after trimming prefix %q", c.Name(), trimPrefix)
+		}
+		if prev, ok := names[name]; ok {
+			return nil, fmt.Errorf("constants %s and %s both map to name %q", prev, c.Name(), name)
+		}
+		names[name] = c.Name()
+		spec.Variants = append(spec.Variants, variant{Const: c.Name(), Name: name})
+	}
+
+	var buf bytes.Buffer
+	if err := enumTemplate.Execute(&buf, spec); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// refersToGenerated reports whether a type-check error message is about a function,
+// variable or method that enumgen generates for typeName.
+func refersToGenerated(msg, typeName string) bool {
+	name, ok := strings.CutPrefix(msg, "undefined: ")
+	if !ok {
+		name, ok = strings.CutPrefix(msg, "undeclared name: ")
+	}
+	if ok {
+		return slices.Contains([]string{
+			typeName + "Values", typeName + "Enum", "Parse" + typeName,
+			"_" + typeName + "Values", "_" + typeName + "Names", "_" + typeName + "Enum",
+		}, name)
+	}
+	for _, method := range []string{"IsValid", "String", "Variant", "MarshalText", "UnmarshalText", "MarshalJSON", "UnmarshalJSON"} {
+		if strings.HasSuffix(msg, "(type "+typeName+" has no field or method "+method+")") ||
+			strings.HasSuffix(msg, "(type *"+typeName+" has no field or method "+method+")") {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	files := []*ast.File{}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(src, []byte(generatedMarker)) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func buildArgs(typeName, trimPrefix string) string {
+	args := "-type=" + typeName
+	if trimPrefix != "" {
+		args += " -trimprefix=" + trimPrefix
+	}
+	return args
+}
+
+var enumTemplate = template.Must(template.New("enum").Parse(`// Code generated by enumgen {{.Args}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/Robert-Safin/go-extra-types/enum"
+)
+
+var _{{.Type}}Values = []{{.Type}}{
+{{- range .Variants}}
+	{{.Const}},
+{{- end}}
+}
+
+var _{{.Type}}Names = []string{
+{{- range .Variants}}
+	{{printf "%q" .Name}},
+{{- end}}
+}
+
+var _{{.Type}}Enum = enum.NewOrderedEnum("{{.Type}}",
+{{- range .Variants}}
+	enum.Entry[{{$.Type}}]{Name: {{printf "%q" .Name}}, Value: {{.Const}}},
+{{- end}}
+)
+
+// {{.Type}}Values returns every {{.Type}} in declaration order.
+func {{.Type}}Values() []{{.Type}} {
+	return slices.Clone(_{{.Type}}Values)
+}
+
+// {{.Type}}Enum returns the runtime enum.Enum for {{.Type}}.
+func {{.Type}}Enum() enum.Enum[{{.Type}}] {
+	return _{{.Type}}Enum
+}
+
+// Parse{{.Type}} returns the {{.Type}} with the given name, or an *enum.UnknownVariantError.
+func Parse{{.Type}}(name string) ({{.Type}}, error) {
+	switch name {
+{{- range .Variants}}
+	case {{printf "%q" .Name}}:
+		return {{.Const}}, nil
+{{- end}}
+	}
+	var zero {{.Type}}
+	return zero, &enum.UnknownVariantError{Enum: "{{.Type}}", Name: name, Valid: slices.Clone(_{{.Type}}Names)}
+}
+
+func (v {{.Type}}) IsValid() bool {
+	switch v {
+	case {{range $i, $v := .Variants}}{{if $i}}, {{end}}{{$v.Const}}{{end}}:
+		return true
+	}
+	return false
+}
+
+func (v {{.Type}}) String() string {
+	switch v {
+{{- range .Variants}}
+	case {{.Const}}:
+		return {{printf "%q" .Name}}
+{{- end}}
+	}
+{{- if .IsString}}
+	return fmt.Sprintf("{{.Type}}(%q)", string(v))
+{{- else}}
+	return fmt.Sprintf("{{.Type}}(%d)", {{.Underlying}}(v))
+{{- end}}
+}
+
+// Variant returns v as a variant of {{.Type}}Enum. It panics if v is not valid.
+func (v {{.Type}}) Variant() enum.Variant[{{.Type}}] {
+	return _{{.Type}}Enum.NewInstance(v.String())
+}
+
+func (v {{.Type}}) MarshalText() ([]byte, error) {
+	if !v.IsValid() {
+		return nil, fmt.Errorf("invalid {{.Type}}: %s", v)
+	}
+	return []byte(v.String()), nil
+}
+
+func (v *{{.Type}}) UnmarshalText(text []byte) error {
+	parsed, err := Parse{{.Type}}(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+func (v {{.Type}}) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (v *{{.Type}}) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(name))
+}
+`))
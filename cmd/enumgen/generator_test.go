@@ -0,0 +1,153 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+var goldenCases = []struct {
+	dir        string
+	typeName   string
+	trimPrefix string
+}{
+	{"status", "Status", "Status"},
+	{"color", "Color", ""},
+}
+
+func TestGenerateGolden(t *testing.T) {
+	for _, c := range goldenCases {
+		t.Run(c.dir, func(t *testing.T) {
+			dir := filepath.Join("testdata", c.dir)
+			got, err := generate(dir, c.typeName, c.trimPrefix)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			golden := filepath.Join(dir, strings.ToLower(c.typeName)+"_enum.golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Reading golden file (run with -update to create it): %v", err)
+			}
+			if string(got) != string(expected) {
+				t.Errorf("Generated code differs from %s; run go test -update to refresh.\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	cases := []struct {
+		name       string
+		typeName   string
+		trimPrefix string
+		expected   string
+	}{
+		{"missing type", "Missing", "", "type Missing not found"},
+		{"no constants", "Unused", "", "no constants of type Unused"},
+		{"unsupported underlying type", "Point", "", "integer or string underlying type"},
+		{"empty name after trimming", "Mode", "ModeOn", "empty after trimming"},
+	}
+
+	dir := t.TempDir()
+	src := "package bad\n\ntype Unused int\n\ntype Point struct{}\n\ntype Mode int\n\nconst ModeOn Mode = 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "bad.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := generate(dir, c.typeName, c.trimPrefix)
+			if err == nil || !strings.Contains(err.Error(), c.expected) {
+				t.Errorf("Expected error containing %q, got %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestGenerateTypeErrors(t *testing.T) {
+	write := func(t *testing.T, src string) string {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "level.go"), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	t.Run("typo in the const block fails", func(t *testing.T) {
+		dir := write(t, "package level\n\ntype Level int\n\nconst (\n\tLow Level = iota\n\tHigh = Lwo + 1\n)\n")
+		_, err := generate(dir, "Level", "")
+		if err == nil || !strings.Contains(err.Error(), "Lwo") {
+			t.Errorf("Expected type-check error, got %v", err)
+		}
+	})
+
+	t.Run("uses of generated identifiers are tolerated", func(t *testing.T) {
+		src := "package level\n\ntype Level int\n\nconst (\n\tLow Level = iota\n\tHigh\n)\n\n" +
+			"func describe(l Level) string { return l.String() }\n\n" +
+			"func all() int { _, _ = ParseLevel(\"Low\"); return len(LevelValues()) }\n"
+		if _, err := generate(write(t, src), "Level", ""); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+// TestGeneratedCodeCompiles builds the golden output together with its input in a
+// throwaway module and runs the behavior tests kept next to the input.
+func TestGeneratedCodeCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a separate module")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not available")
+	}
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range goldenCases {
+		t.Run(c.dir, func(t *testing.T) {
+			src := filepath.Join("testdata", c.dir)
+			dst := t.TempDir()
+			entries, err := os.ReadDir(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range entries {
+				data, err := os.ReadFile(filepath.Join(src, e.Name()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				name := strings.Replace(e.Name(), ".golden", ".go", 1)
+				if err := os.WriteFile(filepath.Join(dst, name), data, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			gomod := "module example.com/" + c.dir + "\n\ngo 1.24\n\n" +
+				"require github.com/Robert-Safin/go-extra-types v0.0.0\n\n" +
+				"replace github.com/Robert-Safin/go-extra-types => " + root + "\n"
+			if err := os.WriteFile(filepath.Join(dst, "go.mod"), []byte(gomod), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			cmd := exec.Command(goBin, "test", "./...")
+			cmd.Dir = dst
+			cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("Generated code failed to build or test: %v\n%s", err, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Command enumgen generates type-safe enums from a named type and its constants.
+//
+// Given
+//
+//	type Status int
+//
+//	const (
+//		StatusActive Status = iota
+//		StatusInactive
+//	)
+//
+// running enumgen -type=Status -trimprefix=Status in the package directory writes
+// status_enum.go with StatusValues, ParseStatus, String, IsValid, JSON and text
+// marshaling, and StatusEnum returning the matching enum.Enum. Use it through
+//
+//	//go:generate go run github.com/Robert-Safin/go-extra-types/cmd/enumgen -type=Status
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the enum type (required)")
+	trimPrefix := flag.String("trimprefix", "", "prefix removed from constant names to form variant names")
+	output := flag.String("output", "", "output file; default <type>_enum.go in the package directory")
+	flag.Parse()
+
+	if *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	src, err := generate(dir, *typeName, *trimPrefix)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enumgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	path := *output
+	if path == "" {
+		path = filepath.Join(dir, strings.ToLower(*typeName)+"_enum.go")
+	}
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "enumgen: %v\n", err)
+		os.Exit(1)
+	}
+}
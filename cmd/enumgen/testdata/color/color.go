@@ -0,0 +1,9 @@
+package color
+
+type Color string
+
+const (
+	Red   Color = "red"
+	Green Color = "green"
+	Blue  Color = "blue"
+)
@@ -0,0 +1,15 @@
+package status
+
+type Status int
+
+const (
+	StatusActive Status = iota + 1
+	StatusSuspended
+	_
+	StatusDeleted
+
+	// StatusDefault is an alias and must not produce a second variant.
+	StatusDefault = StatusActive
+)
+
+const unrelated = 3
@@ -0,0 +1,43 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/enum"
+)
+
+func TestGenerated(t *testing.T) {
+	if !slices.Equal(StatusValues(), []Status{StatusActive, StatusSuspended, StatusDeleted}) {
+		t.Errorf("Unexpected values: %v", StatusValues())
+	}
+	if StatusDeleted.String() != "Deleted" || Status(9).String() != "Status(9)" {
+		t.Error("Unexpected String output")
+	}
+	if s, err := ParseStatus("Suspended"); err != nil || s != StatusSuspended {
+		t.Errorf("Expected StatusSuspended, got %v (%v)", s, err)
+	}
+	var unknown *enum.UnknownVariantError
+	if _, err := ParseStatus("Actve"); !errors.As(err, &unknown) {
+		t.Errorf("Expected *enum.UnknownVariantError, got %v", err)
+	}
+
+	data, err := json.Marshal(map[string]Status{"s": StatusActive})
+	if err != nil || string(data) != `{"s":"Active"}` {
+		t.Errorf("Unexpected JSON %s (%v)", data, err)
+	}
+	var decoded map[string]Status
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded["s"] != StatusActive {
+		t.Errorf("Unexpected decode %v (%v)", decoded, err)
+	}
+	if _, err := json.Marshal(Status(0)); err == nil {
+		t.Error("Expected error marshaling an invalid value")
+	}
+
+	v := StatusDeleted.Variant()
+	if v.Ordinal() != 2 || v.Value() != StatusDeleted || !v.IsInstanceOf(StatusEnum()) {
+		t.Errorf("Unexpected variant %v", v)
+	}
+}
@@ -0,0 +1,127 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+// MarshalText encodes the variant by name.
+func (v Variant[T]) MarshalText() ([]byte, error) {
+	return []byte(v.name), nil
+}
+
+// MarshalJSON encodes the variant as its name.
+func (v Variant[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.name)
+}
+
+// Definition names the Enum a Field validates against. It is implemented by a
+// type of its own, usually an empty struct, so that the zero value of a Field
+// already knows its Enum:
+//
+//	var colors = enum.NewEnum("Colors", map[string]string{...})
+//
+//	type ColorsDef struct{}
+//
+//	func (ColorsDef) Enum() enum.Enum[string] { return colors }
+//
+//	type Row struct {
+//		Color enum.Field[string, ColorsDef]
+//	}
+type Definition[T any] interface {
+	Enum() Enum[T]
+}
+
+// Field holds an optional Variant of the Enum named by D. Unlike Variant it can be decoded,
+// from JSON, text or a database column, because it knows which Enum to validate against.
+// It is also the driver.Valuer for variants, since Variant.Value already returns the payload.
+// The zero value is an empty Field ready to decode into.
+type Field[T any, D Definition[T]] struct {
+	variant option.Option[Variant[T]]
+}
+
+// NewField returns a Field holding v.
+func NewField[T any, D Definition[T]](v Variant[T]) Field[T, D] {
+	return Field[T, D]{variant: option.SomeOption(v)}
+}
+
+func (f Field[T, D]) Variant() option.Option[Variant[T]] {
+	return f.variant
+}
+
+func (f *Field[T, D]) set(name string) error {
+	var def D
+	v, err := def.Enum().Parse(name).Destructure()
+	if err != nil {
+		return err
+	}
+	f.variant = option.SomeOption(v)
+	return nil
+}
+
+// MarshalJSON encodes the variant name, or null when the Field is empty.
+func (f Field[T, D]) MarshalJSON() ([]byte, error) {
+	v, ok := f.variant.Destructure()
+	if !ok {
+		return []byte("null"), nil
+	}
+	return v.MarshalJSON()
+}
+
+// UnmarshalJSON decodes a variant name, or null as an empty Field.
+func (f *Field[T, D]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		f.variant = option.NoneOption[Variant[T]]()
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	return f.set(name)
+}
+
+// MarshalText encodes the variant name, or empty text when the Field is empty.
+func (f Field[T, D]) MarshalText() ([]byte, error) {
+	v, ok := f.variant.Destructure()
+	if !ok {
+		return []byte{}, nil
+	}
+	return v.MarshalText()
+}
+
+// UnmarshalText decodes a variant name, or empty text as an empty Field.
+func (f *Field[T, D]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		f.variant = option.NoneOption[Variant[T]]()
+		return nil
+	}
+	return f.set(string(text))
+}
+
+// Scan implements sql.Scanner for columns holding the variant name. NULL is an empty Field.
+func (f *Field[T, D]) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		f.variant = option.NoneOption[Variant[T]]()
+		return nil
+	case string:
+		return f.set(s)
+	case []byte:
+		return f.set(string(s))
+	}
+	return fmt.Errorf("enum: cannot scan %T into a variant name", src)
+}
+
+// Value implements driver.Valuer, storing the variant name or NULL when the Field is empty.
+func (f Field[T, D]) Value() (driver.Value, error) {
+	v, ok := f.variant.Destructure()
+	if !ok {
+		return nil, nil
+	}
+	return v.name, nil
+}
@@ -0,0 +1,161 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/enum"
+)
+
+var priorities = priorityEnum()
+
+type priorityDef struct{}
+
+func (priorityDef) Enum() enum.Enum[int] {
+	return priorities
+}
+
+type priorityField = enum.Field[int, priorityDef]
+
+type ticket struct {
+	Title    string
+	Priority priorityField
+}
+
+func TestVariantMarshal(t *testing.T) {
+	v := priorityEnum().NewInstance("High")
+
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != `"High"` {
+		t.Errorf(`Expected "High", got %s (%v)`, data, err)
+	}
+	text, err := v.MarshalText()
+	if err != nil || string(text) != "High" {
+		t.Errorf("Expected High, got %s (%v)", text, err)
+	}
+
+	keyed, err := json.Marshal(map[enum.Variant[int]]bool{v: true})
+	if err != nil || string(keyed) != `{"High":true}` {
+		t.Errorf(`Expected {"High":true}, got %s (%v)`, keyed, err)
+	}
+}
+
+func TestFieldJSON(t *testing.T) {
+	e := priorityEnum()
+
+	t.Run("round trip", func(t *testing.T) {
+		in := ticket{Title: "fix", Priority: enum.NewField[int, priorityDef](e.NewInstance("Medium"))}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(data) != `{"Title":"fix","Priority":"Medium"}` {
+			t.Errorf("Unexpected JSON: %s", data)
+		}
+
+		out := ticket{}
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		v := out.Priority.Variant().Unwrap()
+		if v.Name() != "Medium" || v.Value() != 20 || !v.IsInstanceOf(e) {
+			t.Errorf("Expected Medium, got %v", v)
+		}
+	})
+
+	t.Run("null and empty fields", func(t *testing.T) {
+		data, _ := json.Marshal(ticket{})
+		if string(data) != `{"Title":"","Priority":null}` {
+			t.Errorf("Unexpected JSON: %s", data)
+		}
+
+		out := ticket{Priority: enum.NewField[int, priorityDef](e.NewInstance("Low"))}
+		if err := json.Unmarshal([]byte(`{"Priority":null}`), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !out.Priority.Variant().IsNone() {
+			t.Error("Expected null to empty the field")
+		}
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		out := ticket{}
+		err := json.Unmarshal([]byte(`{"Priority":"Urgent"}`), &out)
+		var unknown *enum.UnknownVariantError
+		if !errors.As(err, &unknown) || unknown.Name != "Urgent" {
+			t.Errorf("Expected *UnknownVariantError, got %v", err)
+		}
+	})
+
+	t.Run("decodes into a slice of structs", func(t *testing.T) {
+		var out []ticket
+		data := `[{"Title":"a","Priority":"Low"},{"Title":"b","Priority":null},{"Title":"c","Priority":"High"}]`
+		if err := json.Unmarshal([]byte(data), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(out) != 3 || out[0].Priority.Variant().Unwrap().Name() != "Low" ||
+			!out[1].Priority.Variant().IsNone() || out[2].Priority.Variant().Unwrap().Ordinal() != 2 {
+			t.Errorf("Unexpected tickets: %v", out)
+		}
+	})
+
+	t.Run("decodes into a map", func(t *testing.T) {
+		var out map[string]priorityField
+		if err := json.Unmarshal([]byte(`{"x":"Medium"}`), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if out["x"].Variant().Unwrap().Value() != 20 {
+			t.Errorf("Unexpected field: %v", out["x"])
+		}
+	})
+}
+
+func TestFieldText(t *testing.T) {
+	var f priorityField
+
+	if err := f.UnmarshalText([]byte("High")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text, _ := f.MarshalText()
+	if string(text) != "High" {
+		t.Errorf("Expected High, got %s", text)
+	}
+	if err := f.UnmarshalText([]byte("high")); err == nil {
+		t.Error("Expected error for unknown name")
+	}
+	if err := f.UnmarshalText(nil); err != nil || !f.Variant().IsNone() {
+		t.Error("Expected empty text to empty the field")
+	}
+}
+
+func TestFieldSQL(t *testing.T) {
+	e := priorityEnum()
+
+	t.Run("Value", func(t *testing.T) {
+		v, err := enum.NewField[int, priorityDef](e.NewInstance("Low")).Value()
+		if err != nil || v != "Low" {
+			t.Errorf("Expected Low, got %v (%v)", v, err)
+		}
+		v, err = priorityField{}.Value()
+		if err != nil || v != nil {
+			t.Errorf("Expected NULL, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		var f priorityField
+		if err := f.Scan([]byte("High")); err != nil || f.Variant().Unwrap().Name() != "High" {
+			t.Errorf("Expected High, got %v (%v)", f.Variant(), err)
+		}
+		if err := f.Scan(nil); err != nil || !f.Variant().IsNone() {
+			t.Error("Expected NULL to empty the field")
+		}
+		if err := f.Scan("Nope"); err == nil {
+			t.Error("Expected error for unknown name")
+		}
+		if err := f.Scan(int64(1)); err == nil {
+			t.Error("Expected error for non-text column")
+		}
+	})
+}
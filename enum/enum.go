@@ -3,60 +3,164 @@ package enum
 import (
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+	"github.com/Robert-Safin/go-extra-types/result"
 )
 
 type Enum[T any] struct {
 	name     string
-	variants map[string]T
+	variants []Entry[T]
+	index    map[string]int
 }
 
 type Variant[T any] struct {
-	enum  string
-	name  string
-	value T
+	enum    string
+	name    string
+	ordinal int
+	value   T
+}
+
+// Entry is a name/value pair passed to NewOrderedEnum.
+type Entry[T any] struct {
+	Name  string
+	Value T
 }
 
+// NewEnum orders the variants by name. Use NewOrderedEnum to keep declaration order.
 func NewEnum[T any](name string, variants map[string]T) Enum[T] {
+	entries := make([]Entry[T], 0, len(variants))
+	for _, variant := range slices.Sorted(maps.Keys(variants)) {
+		entries = append(entries, Entry[T]{Name: variant, Value: variants[variant]})
+	}
+	return NewOrderedEnum(name, entries...)
+}
+
+func NewOrderedEnum[T any](name string, variants ...Entry[T]) Enum[T] {
 	if name == "" {
 		panic("Enum name cannot be empty")
 	}
 	if len(variants) == 0 {
 		panic("Enum must have at least one variant")
 	}
-	copy := map[string]T{}
-	maps.Copy(copy, variants)
+	index := make(map[string]int, len(variants))
+	for i, v := range variants {
+		if _, ok := index[v.Name]; ok {
+			panic(fmt.Sprintf("Enum %v has duplicate variant %v", name, v.Name))
+		}
+		index[v.Name] = i
+	}
 	return Enum[T]{
 		name:     name,
-		variants: copy,
+		variants: slices.Clone(variants),
+		index:    index,
 	}
 }
 
+func (e Enum[T]) variant(ordinal int) Variant[T] {
+	entry := e.variants[ordinal]
+	return Variant[T]{enum: e.name, name: entry.Name, ordinal: ordinal, value: entry.Value}
+}
+
 func (e Enum[T]) NewInstance(name string) Variant[T] {
 	if name == "" {
 		panic("Variant name cannot be empty")
 	}
-	v, ok := e.variants[name]
+	i, ok := e.index[name]
 	if !ok {
 		panic(fmt.Sprintf("Enum %v does not have variant %v\n", e.name, name))
 	}
-	return Variant[T]{enum: e.name, name: name, value: v}
+	return e.variant(i)
+}
+
+// UnknownVariantError is returned by Parse for a name that is not a variant of the Enum.
+type UnknownVariantError struct {
+	Enum  string
+	Name  string
+	Valid []string
+}
+
+func (e *UnknownVariantError) Error() string {
+	return fmt.Sprintf("enum %s does not have variant %q, valid variants: %s", e.Enum, e.Name, strings.Join(e.Valid, ", "))
+}
+
+func (e Enum[T]) Parse(name string) result.Result[Variant[T]] {
+	i, ok := e.index[name]
+	if !ok {
+		return result.NewErr[Variant[T]](e.unknown(name))
+	}
+	return result.NewOk(e.variant(i))
+}
+
+// ParseFold is Parse ignoring case, so "red" finds the variant "Red".
+// An exact match wins, then the first variant in order that matches.
+func (e Enum[T]) ParseFold(name string) result.Result[Variant[T]] {
+	if i, ok := e.index[name]; ok {
+		return result.NewOk(e.variant(i))
+	}
+	for i, entry := range e.variants {
+		if strings.EqualFold(entry.Name, name) {
+			return result.NewOk(e.variant(i))
+		}
+	}
+	return result.NewErr[Variant[T]](e.unknown(name))
+}
+
+func (e Enum[T]) Lookup(name string) option.Option[Variant[T]] {
+	i, ok := e.index[name]
+	if !ok {
+		return option.NoneOption[Variant[T]]()
+	}
+	return option.SomeOption(e.variant(i))
+}
+
+func (e Enum[T]) unknown(name string) *UnknownVariantError {
+	return &UnknownVariantError{Enum: e.name, Name: name, Valid: e.VariantNames()}
 }
 
+// VariantNames returns the variant names in order.
 func (e Enum[T]) VariantNames() []string {
 	names := make([]string, 0, len(e.variants))
-	for name := range e.variants {
-		names = append(names, name)
+	for _, entry := range e.variants {
+		names = append(names, entry.Name)
 	}
 	return names
 }
 
+// Variants returns every variant in order.
+func (e Enum[T]) Variants() []Variant[T] {
+	variants := make([]Variant[T], 0, len(e.variants))
+	for i := range e.variants {
+		variants = append(variants, e.variant(i))
+	}
+	return variants
+}
+
+// Next returns the variant after v, or None if v is the last one or not a variant of e.
+func (e Enum[T]) Next(v Variant[T]) option.Option[Variant[T]] {
+	if !v.IsInstanceOf(e) || v.ordinal+1 >= len(e.variants) {
+		return option.NoneOption[Variant[T]]()
+	}
+	return option.SomeOption(e.variant(v.ordinal + 1))
+}
+
+// Prev returns the variant before v, or None if v is the first one or not a variant of e.
+func (e Enum[T]) Prev(v Variant[T]) option.Option[Variant[T]] {
+	if !v.IsInstanceOf(e) || v.ordinal == 0 {
+		return option.NoneOption[Variant[T]]()
+	}
+	return option.SomeOption(e.variant(v.ordinal - 1))
+}
+
 func (e Enum[T]) String() string {
 	return fmt.Sprintf("Enum{name: %s, variant count: %v, variants: %v}", e.name, len(e.variants), e.variants)
 }
 
 func (v Variant[T]) IsInstanceOf(enum Enum[T]) bool {
-	_, ok := enum.variants[v.name]
-	return ok && enum.name == v.enum
+	i, ok := enum.index[v.name]
+	return ok && enum.name == v.enum && i == v.ordinal
 }
 
 func (v Variant[T]) Value() T {
@@ -66,6 +170,11 @@ func (v Variant[T]) Name() string {
 	return v.name
 }
 
+// Ordinal is the position of the variant in its Enum, starting at 0.
+func (v Variant[T]) Ordinal() int {
+	return v.ordinal
+}
+
 func (v Variant[T]) String() string {
 	return fmt.Sprintf("Variant{enum: %s, name: %s}", v.enum, v.name)
 }
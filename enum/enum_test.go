@@ -1,9 +1,11 @@
 package enum_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Robert-Safin/go-extra-types/enum"
+	"github.com/Robert-Safin/go-extra-types/result"
 )
 
 func TestNewEnum(t *testing.T) {
@@ -306,3 +308,168 @@ func someContains(s, substr string) bool {
 	}
 	return false
 }
+
+func colorEnum() enum.Enum[string] {
+	return enum.NewEnum("Colors", map[string]string{
+		"Red":   "#FF0000",
+		"Green": "#00FF00",
+		"Blue":  "#0000FF",
+	})
+}
+
+func TestParse(t *testing.T) {
+	e := colorEnum()
+
+	t.Run("known name", func(t *testing.T) {
+		v := e.Parse("Green").Unwrap()
+		if v.Name() != "Green" || v.Value() != "#00FF00" || !v.IsInstanceOf(e) {
+			t.Errorf("Expected Green variant, got %v", v)
+		}
+	})
+
+	t.Run("unknown name returns UnknownVariantError", func(t *testing.T) {
+		res := e.Parse("Purple")
+		unknown, ok := result.AsError[*enum.UnknownVariantError](res)
+		if !ok {
+			t.Fatalf("Expected *UnknownVariantError, got %v", res.Error())
+		}
+		if unknown.Enum != "Colors" || unknown.Name != "Purple" {
+			t.Errorf("Unexpected error fields: %+v", unknown)
+		}
+		if strings.Join(unknown.Valid, ",") != "Blue,Green,Red" {
+			t.Errorf("Expected sorted valid names, got %v", unknown.Valid)
+		}
+		expected := `enum Colors does not have variant "Purple", valid variants: Blue, Green, Red`
+		if unknown.Error() != expected {
+			t.Errorf("Expected %q, got %q", expected, unknown.Error())
+		}
+	})
+
+	t.Run("Parse is case-sensitive", func(t *testing.T) {
+		if !e.Parse("red").IsErr() {
+			t.Error("Expected lowercase name to fail")
+		}
+	})
+
+	t.Run("empty name does not panic", func(t *testing.T) {
+		if !e.Parse("").IsErr() {
+			t.Error("Expected empty name to fail")
+		}
+	})
+}
+
+func TestParseFold(t *testing.T) {
+	e := colorEnum()
+
+	v := e.ParseFold("rEd").Unwrap()
+	if v.Name() != "Red" {
+		t.Errorf("Expected canonical name Red, got %s", v.Name())
+	}
+	if _, ok := result.AsError[*enum.UnknownVariantError](e.ParseFold("purple")); !ok {
+		t.Error("Expected *UnknownVariantError")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	e := colorEnum()
+
+	if e.Lookup("Blue").Unwrap().Value() != "#0000FF" {
+		t.Error("Expected Some(Blue)")
+	}
+	if !e.Lookup("Purple").IsNone() {
+		t.Error("Expected None for unknown name")
+	}
+}
+
+func priorityEnum() enum.Enum[int] {
+	return enum.NewOrderedEnum("Priority",
+		enum.Entry[int]{Name: "Low", Value: 10},
+		enum.Entry[int]{Name: "Medium", Value: 20},
+		enum.Entry[int]{Name: "High", Value: 30},
+	)
+}
+
+func TestNewOrderedEnum(t *testing.T) {
+	t.Run("keeps declaration order", func(t *testing.T) {
+		e := priorityEnum()
+		if got := strings.Join(e.VariantNames(), ","); got != "Low,Medium,High" {
+			t.Errorf("Expected Low,Medium,High, got %s", got)
+		}
+	})
+
+	t.Run("map constructor orders by name", func(t *testing.T) {
+		if got := strings.Join(colorEnum().VariantNames(), ","); got != "Blue,Green,Red" {
+			t.Errorf("Expected Blue,Green,Red, got %s", got)
+		}
+	})
+
+	t.Run("duplicate names panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for duplicate variant")
+			}
+		}()
+		enum.NewOrderedEnum("Dup", enum.Entry[int]{Name: "A"}, enum.Entry[int]{Name: "A"})
+	})
+
+	t.Run("no variants panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for no variants")
+			}
+		}()
+		enum.NewOrderedEnum[int]("Empty")
+	})
+
+	t.Run("input slice is copied", func(t *testing.T) {
+		entries := []enum.Entry[int]{{Name: "A", Value: 1}}
+		e := enum.NewOrderedEnum("Copy", entries...)
+		entries[0].Value = 2
+		if e.NewInstance("A").Value() != 1 {
+			t.Error("Expected enum to be unaffected by later changes")
+		}
+	})
+}
+
+func TestVariantsAndOrdinals(t *testing.T) {
+	e := priorityEnum()
+	variants := e.Variants()
+
+	if len(variants) != 3 {
+		t.Fatalf("Expected 3 variants, got %d", len(variants))
+	}
+	for i, v := range variants {
+		if v.Ordinal() != i {
+			t.Errorf("Expected ordinal %d for %s, got %d", i, v.Name(), v.Ordinal())
+		}
+	}
+	if variants[2].Name() != "High" || variants[2].Value() != 30 {
+		t.Errorf("Expected High=30 last, got %v", variants[2])
+	}
+	if e.NewInstance("Medium").Ordinal() != 1 || e.Parse("Medium").Unwrap().Ordinal() != 1 {
+		t.Error("Expected every constructor to set the ordinal")
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	e := priorityEnum()
+	low, medium, high := e.NewInstance("Low"), e.NewInstance("Medium"), e.NewInstance("High")
+
+	if e.Next(low).Unwrap().Name() != "Medium" || e.Next(medium).Unwrap().Name() != "High" {
+		t.Error("Expected Next to follow declaration order")
+	}
+	if !e.Next(high).IsNone() {
+		t.Error("Expected None after the last variant")
+	}
+	if e.Prev(high).Unwrap().Name() != "Medium" {
+		t.Error("Expected Prev to go back")
+	}
+	if !e.Prev(low).IsNone() {
+		t.Error("Expected None before the first variant")
+	}
+
+	other := enum.NewOrderedEnum("Other", enum.Entry[int]{Name: "Low"}, enum.Entry[int]{Name: "High"})
+	if !e.Next(other.NewInstance("Low")).IsNone() {
+		t.Error("Expected None for a variant of another enum")
+	}
+}
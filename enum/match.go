@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+// MatchError lists what made a Match incomplete or invalid.
+type MatchError struct {
+	Enum      string
+	Missing   []string
+	Unknown   []string
+	Duplicate []string
+}
+
+func (e *MatchError) Error() string {
+	parts := []string{}
+	if len(e.Missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Unknown) > 0 {
+		parts = append(parts, "unknown "+strings.Join(e.Unknown, ", "))
+	}
+	if len(e.Duplicate) > 0 {
+		parts = append(parts, "duplicate "+strings.Join(e.Duplicate, ", "))
+	}
+	return fmt.Sprintf("match on enum %s: %s", e.Enum, strings.Join(parts, "; "))
+}
+
+// MatchBuilder collects one handler per variant name; Build checks them against the Enum.
+type MatchBuilder[T, R any] struct {
+	enum      Enum[T]
+	arms      map[string]func(v Variant[T]) R
+	order     []string
+	duplicate []string
+	fallback  func(v Variant[T]) R
+}
+
+// Match dispatches a Variant to the handler registered for its name.
+type Match[T, R any] struct {
+	enum     Enum[T]
+	arms     map[string]func(v Variant[T]) R
+	fallback func(v Variant[T]) R
+}
+
+func NewMatch[T, R any](e Enum[T]) *MatchBuilder[T, R] {
+	return &MatchBuilder[T, R]{enum: e, arms: map[string]func(v Variant[T]) R{}}
+}
+
+func (b *MatchBuilder[T, R]) Case(name string, handler func(v Variant[T]) R) *MatchBuilder[T, R] {
+	if _, ok := b.arms[name]; ok {
+		b.duplicate = append(b.duplicate, name)
+		return b
+	}
+	b.arms[name] = handler
+	b.order = append(b.order, name)
+	return b
+}
+
+// Default handles every variant without a Case. Without it Build requires every variant to be covered.
+func (b *MatchBuilder[T, R]) Default(handler func(v Variant[T]) R) *MatchBuilder[T, R] {
+	b.fallback = handler
+	return b
+}
+
+// Build returns Err holding a *MatchError if a Case names no variant of the Enum,
+// a name has two Cases, or a variant is left uncovered without a Default.
+func (b *MatchBuilder[T, R]) Build() result.Result[Match[T, R]] {
+	matchErr := &MatchError{Enum: b.enum.name, Duplicate: b.duplicate}
+	for _, name := range b.order {
+		if _, ok := b.enum.index[name]; !ok {
+			matchErr.Unknown = append(matchErr.Unknown, name)
+		}
+	}
+	if b.fallback == nil {
+		for _, entry := range b.enum.variants {
+			if _, ok := b.arms[entry.Name]; !ok {
+				matchErr.Missing = append(matchErr.Missing, entry.Name)
+			}
+		}
+	}
+	if len(matchErr.Missing)+len(matchErr.Unknown)+len(matchErr.Duplicate) > 0 {
+		return result.NewErr[Match[T, R]](matchErr)
+	}
+	return result.NewOk(Match[T, R]{
+		enum:     b.enum,
+		arms:     maps.Clone(b.arms),
+		fallback: b.fallback,
+	})
+}
+
+// MustBuild is Build that panics on an invalid Match.
+func (b *MatchBuilder[T, R]) MustBuild() Match[T, R] {
+	return b.Build().Unwrap()
+}
+
+// Run calls the handler for v and returns its value. It panics if v is not a variant of the matched Enum.
+func (m Match[T, R]) Run(v Variant[T]) R {
+	if !v.IsInstanceOf(m.enum) {
+		panic(fmt.Sprintf("Variant %v is not an instance of Enum %v", v.name, m.enum.name))
+	}
+	if handler, ok := m.arms[v.name]; ok {
+		return handler(v)
+	}
+	return m.fallback(v)
+}
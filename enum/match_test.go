@@ -0,0 +1,107 @@
+package enum_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/enum"
+)
+
+func describe(v enum.Variant[int]) string {
+	return strings.ToLower(v.Name())
+}
+
+func TestMatch(t *testing.T) {
+	e := priorityEnum()
+
+	t.Run("dispatches to the handler for each variant", func(t *testing.T) {
+		m := enum.NewMatch[int, string](e).
+			Case("Low", func(enum.Variant[int]) string { return "later" }).
+			Case("Medium", describe).
+			Case("High", func(v enum.Variant[int]) string { return "now" }).
+			MustBuild()
+
+		got := []string{}
+		for _, v := range e.Variants() {
+			got = append(got, m.Run(v))
+		}
+		if strings.Join(got, ",") != "later,medium,now" {
+			t.Errorf("Unexpected results: %v", got)
+		}
+	})
+
+	t.Run("missing variants fail without a default", func(t *testing.T) {
+		res := enum.NewMatch[int, string](e).Case("Low", describe).Build()
+		var matchErr *enum.MatchError
+		if !errors.As(res.Error(), &matchErr) {
+			t.Fatalf("Expected *MatchError, got %v", res.Error())
+		}
+		if strings.Join(matchErr.Missing, ",") != "Medium,High" {
+			t.Errorf("Expected Medium,High missing, got %v", matchErr.Missing)
+		}
+		if matchErr.Error() != "match on enum Priority: missing Medium, High" {
+			t.Errorf("Unexpected message: %s", matchErr.Error())
+		}
+	})
+
+	t.Run("unknown and duplicate names fail", func(t *testing.T) {
+		res := enum.NewMatch[int, string](e).
+			Case("Low", describe).
+			Case("Low", describe).
+			Case("Urgent", describe).
+			Default(describe).
+			Build()
+		var matchErr *enum.MatchError
+		if !errors.As(res.Error(), &matchErr) {
+			t.Fatalf("Expected *MatchError, got %v", res.Error())
+		}
+		if len(matchErr.Missing) != 0 || strings.Join(matchErr.Unknown, ",") != "Urgent" || strings.Join(matchErr.Duplicate, ",") != "Low" {
+			t.Errorf("Unexpected error fields: %+v", matchErr)
+		}
+	})
+
+	t.Run("default is opt-in and handles the rest", func(t *testing.T) {
+		m := enum.NewMatch[int, string](e).
+			Case("High", func(enum.Variant[int]) string { return "now" }).
+			Default(func(v enum.Variant[int]) string { return "default " + describe(v) }).
+			MustBuild()
+
+		if m.Run(e.NewInstance("High")) != "now" {
+			t.Error("Expected explicit case to win")
+		}
+		if m.Run(e.NewInstance("Low")) != "default low" {
+			t.Error("Expected default for uncovered variant")
+		}
+	})
+
+	t.Run("built Match is not changed by later cases", func(t *testing.T) {
+		b := enum.NewMatch[int, string](e).Default(describe)
+		m := b.MustBuild()
+		b.Case("Low", func(enum.Variant[int]) string { return "later" })
+
+		if m.Run(e.NewInstance("Low")) != "low" {
+			t.Error("Expected the built Match to keep its own arms")
+		}
+	})
+
+	t.Run("MustBuild panics on an incomplete match", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		enum.NewMatch[int, string](e).MustBuild()
+	})
+
+	t.Run("Run panics for a variant of another enum", func(t *testing.T) {
+		m := enum.NewMatch[int, string](e).Default(describe).MustBuild()
+		other := enum.NewOrderedEnum("Other", enum.Entry[int]{Name: "Low"})
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		m.Run(other.NewInstance("Low"))
+	})
+}
@@ -0,0 +1,32 @@
+package option
+
+import "cmp"
+
+// Equal reports whether both are None or both are Some with equal values.
+func Equal[T comparable](a, b Option[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+func EqualFunc[T any](a, b Option[T], eq func(a, b T) bool) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() == b.IsNone()
+	}
+	return eq(a.val, b.val)
+}
+
+// Compare orders None before any Some and Somes by their values, like Rust's Ord.
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+func CompareFunc[T any](a, b Option[T], compare func(a, b T) int) int {
+	switch {
+	case a.IsNone() && b.IsNone():
+		return 0
+	case a.IsNone():
+		return -1
+	case b.IsNone():
+		return 1
+	}
+	return compare(a.val, b.val)
+}
@@ -0,0 +1,76 @@
+package option_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+func TestEqual(t *testing.T) {
+	if !option.Equal(option.SomeOption(1), option.SomeOption(1)) {
+		t.Error("Expected equal Somes to be equal")
+	}
+	if option.Equal(option.SomeOption(1), option.SomeOption(2)) {
+		t.Error("Expected different Somes to differ")
+	}
+	if option.Equal(option.SomeOption(0), option.NoneOption[int]()) {
+		t.Error("Expected Some(0) to differ from None")
+	}
+	if !option.Equal(option.NewInfer(5, false), option.NoneOption[int]()) {
+		t.Error("Expected None to equal None")
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+
+	if !option.EqualFunc(option.SomeOption("Go"), option.SomeOption("GO"), fold) {
+		t.Error("Expected case-insensitive match")
+	}
+	if option.EqualFunc(option.SomeOption("Go"), option.NoneOption[string](), fold) {
+		t.Error("Expected Some to differ from None")
+	}
+	if !option.EqualFunc(option.NoneOption[string](), option.NoneOption[string](), fold) {
+		t.Error("Expected None to equal None")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	none := option.NoneOption[int]()
+
+	if option.Compare(none, option.SomeOption(-100)) != -1 {
+		t.Error("Expected None to sort before Some")
+	}
+	if option.Compare(option.SomeOption(-100), none) != 1 {
+		t.Error("Expected Some to sort after None")
+	}
+	if option.Compare(none, none) != 0 {
+		t.Error("Expected None to equal None")
+	}
+	if option.Compare(option.SomeOption(1), option.SomeOption(2)) != -1 {
+		t.Error("Expected Some(1) < Some(2)")
+	}
+
+	t.Run("sorts with slices.SortFunc", func(t *testing.T) {
+		opts := []option.Option[int]{option.SomeOption(3), none, option.SomeOption(1), none}
+		slices.SortFunc(opts, option.Compare[int])
+
+		expected := []option.Option[int]{none, none, option.SomeOption(1), option.SomeOption(3)}
+		if !slices.EqualFunc(opts, expected, option.Equal[int]) {
+			t.Errorf("Unexpected order: %v", opts)
+		}
+	})
+}
+
+func TestCompareFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+
+	if option.CompareFunc(option.SomeOption("aaa"), option.SomeOption("b"), byLen) <= 0 {
+		t.Error("Expected longer string to compare greater")
+	}
+	if option.CompareFunc(option.NoneOption[string](), option.SomeOption(""), byLen) != -1 {
+		t.Error("Expected None to sort before Some")
+	}
+}
@@ -0,0 +1,38 @@
+package option
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// String renders Some(v) or None.
+func (o Option[T]) String() string {
+	return fmt.Sprint(o)
+}
+
+// Format renders Some(v) or None, applying the verb and flags to v.
+// %#v renders the Go syntax that builds the Option.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		if o.IsNone() {
+			fmt.Fprintf(f, "option.NoneOption[%v]()", reflect.TypeFor[T]())
+			return
+		}
+		fmt.Fprintf(f, "option.SomeOption[%v](%#v)", reflect.TypeFor[T](), o.val)
+		return
+	}
+	if o.IsNone() {
+		fmt.Fprint(f, "None")
+		return
+	}
+	fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.val)
+}
+
+// LogValue renders None as a null attribute and Some(v) as v.
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.val)
+}
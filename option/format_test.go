@@ -0,0 +1,70 @@
+package option_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+
+	cases := []struct {
+		name     string
+		format   string
+		arg      any
+		expected string
+	}{
+		{"Some %v", "%v", option.SomeOption(42), "Some(42)"},
+		{"None %v", "%v", option.NoneOption[int](), "None"},
+		{"Some string %v", "%v", option.SomeOption("hi"), "Some(hi)"},
+		{"Some %q", "%q", option.SomeOption("hi"), `Some("hi")`},
+		{"Some %05d", "%05d", option.SomeOption(42), "Some(00042)"},
+		{"Some %+v", "%+v", option.SomeOption(point{1, 2}), "Some({X:1 Y:2})"},
+		{"Some %#v", "%#v", option.SomeOption(42), "option.SomeOption[int](42)"},
+		{"None %#v", "%#v", option.NoneOption[string](), "option.NoneOption[string]()"},
+		{"interface None %#v", "%#v", option.NoneOption[error](), "option.NoneOption[error]()"},
+		{"interface Some %#v", "%#v", option.SomeOption[any](1), "option.SomeOption[interface {}](1)"},
+		{"nested", "%v", option.SomeOption(option.NoneOption[int]()), "Some(None)"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := fmt.Sprintf(c.format, c.arg); got != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+
+	t.Run("String", func(t *testing.T) {
+		if option.SomeOption(0).String() != "Some(0)" {
+			t.Errorf("Expected Some(0), got %s", option.SomeOption(0).String())
+		}
+		if option.NoneOption[int]().String() != "None" {
+			t.Errorf("Expected None, got %s", option.NoneOption[int]().String())
+		}
+	})
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("user", "id", option.SomeOption(7), "email", option.NoneOption[string]())
+
+	got := strings.TrimSpace(buf.String())
+	expected := `{"level":"INFO","msg":"user","id":7,"email":null}`
+	if got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,37 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes None as null and Some(v) as v.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.val)
+}
+
+// UnmarshalJSON decodes null as None and anything else as Some.
+// A field missing from the payload is left untouched, which for a zero Option is None.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = NoneOption[T]()
+		return nil
+	}
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*o = SomeOption(val)
+	return nil
+}
+
+// IsZero reports whether the Option is None, which lets `json:",omitzero"` drop None fields.
+// `omitempty` has no effect on struct types, Option included.
+func (o Option[T]) IsZero() bool {
+	return o.IsNone()
+}
@@ -0,0 +1,125 @@
+package option_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+type patchPayload struct {
+	Name  option.Option[string] `json:"name"`
+	Age   option.Option[int]    `json:"age,omitzero"`
+	Admin option.Option[bool]   `json:"admin,omitzero"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("Some encodes as the inner value", func(t *testing.T) {
+		data, err := json.Marshal(option.SomeOption(42))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(data) != "42" {
+			t.Errorf("Expected 42, got %s", data)
+		}
+	})
+
+	t.Run("None encodes as null", func(t *testing.T) {
+		data, err := json.Marshal(option.NoneOption[int]())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(data) != "null" {
+			t.Errorf("Expected null, got %s", data)
+		}
+	})
+
+	t.Run("Some zero value is kept", func(t *testing.T) {
+		data, err := json.Marshal(option.SomeOption(""))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(data) != `""` {
+			t.Errorf(`Expected "", got %s`, data)
+		}
+	})
+
+	t.Run("omitzero drops None but keeps Some zero", func(t *testing.T) {
+		p := patchPayload{
+			Name:  option.NoneOption[string](),
+			Age:   option.NoneOption[int](),
+			Admin: option.SomeOption(false),
+		}
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := `{"name":null,"admin":false}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("value decodes as Some", func(t *testing.T) {
+		var opt option.Option[int]
+		if err := json.Unmarshal([]byte("7"), &opt); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !opt.IsSome() || opt.Unwrap() != 7 {
+			t.Errorf("Expected Some(7), got %v", opt)
+		}
+	})
+
+	t.Run("null decodes as None", func(t *testing.T) {
+		opt := option.SomeOption(3)
+		if err := json.Unmarshal([]byte("null"), &opt); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !opt.IsNone() {
+			t.Error("Expected None after decoding null")
+		}
+	})
+
+	t.Run("missing and zero fields are distinguished", func(t *testing.T) {
+		var p patchPayload
+		if err := json.Unmarshal([]byte(`{"age":0}`), &p); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !p.Name.IsNone() {
+			t.Error("Expected missing name to be None")
+		}
+		if !p.Age.IsSome() || p.Age.Unwrap() != 0 {
+			t.Error("Expected age to be Some(0)")
+		}
+		if !p.Admin.IsNone() {
+			t.Error("Expected missing admin to be None")
+		}
+	})
+
+	t.Run("type mismatch returns error", func(t *testing.T) {
+		var opt option.Option[int]
+		if err := json.Unmarshal([]byte(`"nope"`), &opt); err == nil {
+			t.Error("Expected error for mismatched type")
+		}
+		if !opt.IsNone() {
+			t.Error("Expected option to stay None on error")
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		in := option.SomeOption([]string{"a", "b"})
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var out option.Option[[]string]
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(out.Unwrap()) != 2 || out.Unwrap()[1] != "b" {
+			t.Errorf("Expected [a b], got %v", out.Unwrap())
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package option
 
 import (
+	"math"
 	"reflect"
 )
 
@@ -21,7 +22,7 @@ func NoneOption[T any]() Option[T] {
 func NewInfer[T any](val T, infer ...bool) Option[T] {
 	var zeroValue T
 	if len(infer) == 0 {
-		if reflect.ValueOf(val).IsZero() {
+		if isZero(val) {
 			return Option[T]{val: zeroValue, ok: false}
 		} else {
 			return Option[T]{val: val, ok: true}
@@ -34,6 +35,61 @@ func NewInfer[T any](val T, infer ...bool) Option[T] {
 
 }
 
+// FromComparable is NewInfer without reflection for non-zero values: val is None when it
+// equals the zero value of T and is not -0.0, which == treats as zero but NewInfer does not.
+// For an interface T only nil is None, while NewInfer also looks at the value inside.
+func FromComparable[T comparable](val T) Option[T] {
+	var zeroValue T
+	if val == zeroValue && isZero(val) {
+		return Option[T]{val: zeroValue, ok: false}
+	}
+	return Option[T]{val: val, ok: true}
+}
+
+// isZero avoids reflection for every basic scalar type and treats a nil interface as zero.
+// Floats compare by bits so that -0.0 is non-zero, matching reflect.Value.IsZero.
+func isZero[T any](val T) bool {
+	switch v := any(val).(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case int8:
+		return v == 0
+	case int16:
+		return v == 0
+	case int32:
+		return v == 0
+	case int64:
+		return v == 0
+	case uint:
+		return v == 0
+	case uint8:
+		return v == 0
+	case uint16:
+		return v == 0
+	case uint32:
+		return v == 0
+	case uint64:
+		return v == 0
+	case uintptr:
+		return v == 0
+	case float32:
+		return math.Float32bits(v) == 0
+	case float64:
+		return math.Float64bits(v) == 0
+	case complex64:
+		return math.Float32bits(real(v)) == 0 && math.Float32bits(imag(v)) == 0
+	case complex128:
+		return math.Float64bits(real(v)) == 0 && math.Float64bits(imag(v)) == 0
+	}
+	return reflect.ValueOf(val).IsZero()
+}
+
 func (o Option[T]) Destructure() (T, bool) {
 	return o.val, o.IsSome()
 }
@@ -74,3 +130,139 @@ func (o Option[T]) UnwrapOrFunc(f func(o Option[T]) T) T {
 	}
 	return f(o)
 }
+
+func (o Option[T]) Expect(msg string) T {
+	if o.IsSome() {
+		return o.val
+	}
+	panic(msg)
+}
+
+func (o Option[T]) Filter(predicate func(val T) bool) Option[T] {
+	if o.IsSome() && predicate(o.val) {
+		return o
+	}
+	return NoneOption[T]()
+}
+
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+	return other
+}
+
+func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+	return f()
+}
+
+func (o Option[T]) Xor(other Option[T]) Option[T] {
+	if o.IsSome() && other.IsNone() {
+		return o
+	}
+	if o.IsNone() && other.IsSome() {
+		return other
+	}
+	return NoneOption[T]()
+}
+
+func (o Option[T]) Inspect(f func(val T)) Option[T] {
+	if o.IsSome() {
+		f(o.val)
+	}
+	return o
+}
+
+func Map[T, U any](o Option[T], f func(val T) U) Option[U] {
+	if o.IsNone() {
+		return NoneOption[U]()
+	}
+	return SomeOption(f(o.val))
+}
+
+func AndThen[T, U any](o Option[T], f func(val T) Option[U]) Option[U] {
+	if o.IsNone() {
+		return NoneOption[U]()
+	}
+	return f(o.val)
+}
+
+func FlatMap[T, U any](o Option[T], f func(val T) Option[U]) Option[U] {
+	return AndThen(o, f)
+}
+
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	if o.IsNone() {
+		return NoneOption[T]()
+	}
+	return o.val
+}
+
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+func Zip[T, U any](a Option[T], b Option[U]) Option[Pair[T, U]] {
+	if a.IsNone() || b.IsNone() {
+		return NoneOption[Pair[T, U]]()
+	}
+	return SomeOption(Pair[T, U]{First: a.val, Second: b.val})
+}
+
+func Unzip[T, U any](o Option[Pair[T, U]]) (Option[T], Option[U]) {
+	if o.IsNone() {
+		return NoneOption[T](), NoneOption[U]()
+	}
+	return SomeOption(o.val.First), SomeOption(o.val.Second)
+}
+
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return NoneOption[T]()
+	}
+	return SomeOption(*ptr)
+}
+
+// ToPtr returns a pointer to a copy of the value, or nil for None.
+func (o Option[T]) ToPtr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	val := o.val
+	return &val
+}
+
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = NoneOption[T]()
+	return old
+}
+
+func (o *Option[T]) Replace(val T) Option[T] {
+	old := *o
+	*o = SomeOption(val)
+	return old
+}
+
+func (o *Option[T]) Insert(val T) *T {
+	*o = SomeOption(val)
+	return &o.val
+}
+
+func (o *Option[T]) GetOrInsert(val T) *T {
+	if o.IsNone() {
+		*o = SomeOption(val)
+	}
+	return &o.val
+}
+
+func (o *Option[T]) GetOrInsertWith(f func() T) *T {
+	if o.IsNone() {
+		*o = SomeOption(f())
+	}
+	return &o.val
+}
@@ -1,6 +1,7 @@
 package option_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/Robert-Safin/go-extra-types/option"
@@ -122,6 +123,102 @@ func TestNewInfer(t *testing.T) {
 	})
 }
 
+func TestNewInferNil(t *testing.T) {
+	t.Run("nil interface is None", func(t *testing.T) {
+		var err error
+		opt := option.NewInfer(err)
+		if !opt.IsNone() {
+			t.Error("Expected None for nil interface")
+		}
+
+		anyOpt := option.NewInfer[any](nil)
+		if !anyOpt.IsNone() {
+			t.Error("Expected None for nil any")
+		}
+	})
+
+	t.Run("nil pointer is None", func(t *testing.T) {
+		var p *int
+		if !option.NewInfer(p).IsNone() {
+			t.Error("Expected None for nil pointer")
+		}
+	})
+
+	t.Run("non-nil pointer to zero is Some", func(t *testing.T) {
+		v := 0
+		if !option.NewInfer(&v).IsSome() {
+			t.Error("Expected Some for pointer to zero value")
+		}
+	})
+
+	t.Run("zero struct is None", func(t *testing.T) {
+		type point struct{ X, Y int }
+		if !option.NewInfer(point{}).IsNone() {
+			t.Error("Expected None for zero struct")
+		}
+		if !option.NewInfer(point{X: 1}).IsSome() {
+			t.Error("Expected Some for non-zero struct")
+		}
+	})
+}
+
+func TestNewInferScalars(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	cases := []struct {
+		name     string
+		isNone   bool
+		expected bool
+	}{
+		{"int8 zero", option.NewInfer(int8(0)).IsNone(), true},
+		{"uint16 zero", option.NewInfer(uint16(0)).IsNone(), true},
+		{"byte non-zero", option.NewInfer(byte(1)).IsNone(), false},
+		{"uintptr zero", option.NewInfer(uintptr(0)).IsNone(), true},
+		{"float32 zero", option.NewInfer(float32(0)).IsNone(), true},
+		{"float64 non-zero", option.NewInfer(1.5).IsNone(), false},
+		{"float64 negative zero", option.NewInfer(negZero).IsNone(), false},
+		{"complex128 zero", option.NewInfer(complex(0, 0)).IsNone(), true},
+		{"complex64 non-zero", option.NewInfer(complex64(complex(0, 1))).IsNone(), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.isNone != c.expected {
+				t.Errorf("Expected IsNone %v, got %v", c.expected, c.isNone)
+			}
+		})
+	}
+}
+
+func TestFromComparable(t *testing.T) {
+	if !option.FromComparable("").IsNone() {
+		t.Error("Expected None for empty string")
+	}
+	if option.FromComparable("hi").Unwrap() != "hi" {
+		t.Error("Expected Some('hi')")
+	}
+	if !option.FromComparable(0).IsNone() {
+		t.Error("Expected None for zero int")
+	}
+
+	var p *int
+	if !option.FromComparable(p).IsNone() {
+		t.Error("Expected None for nil pointer")
+	}
+
+	var err error
+	if !option.FromComparable(err).IsNone() {
+		t.Error("Expected None for nil interface")
+	}
+
+	negZero := math.Copysign(0, -1)
+	if option.FromComparable(negZero) != option.NewInfer(negZero) || option.FromComparable(negZero).IsNone() {
+		t.Error("Expected Some(-0.0), as NewInfer returns")
+	}
+	type point struct{ X, Y float64 }
+	if option.FromComparable(point{Y: negZero}).IsNone() != option.NewInfer(point{Y: negZero}).IsNone() {
+		t.Error("Expected FromComparable and NewInfer to agree on a struct holding -0.0")
+	}
+}
+
 func TestDestructure(t *testing.T) {
 	t.Run("destructures Some option", func(t *testing.T) {
 		opt := option.SomeOption("hello")
@@ -352,3 +449,339 @@ func TestIsMethodsConsistency(t *testing.T) {
 		}
 	})
 }
+
+func TestExpect(t *testing.T) {
+	t.Run("returns value for Some", func(t *testing.T) {
+		if option.SomeOption(5).Expect("missing") != 5 {
+			t.Error("Expected 5")
+		}
+	})
+
+	t.Run("panics with message for None", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "missing value" {
+				t.Errorf("Expected panic 'missing value', got %v", r)
+			}
+		}()
+		option.NoneOption[int]().Expect("missing value")
+	})
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	if !option.SomeOption(4).Filter(isEven).IsSome() {
+		t.Error("Expected Some when predicate holds")
+	}
+	if !option.SomeOption(3).Filter(isEven).IsNone() {
+		t.Error("Expected None when predicate fails")
+	}
+	if !option.NoneOption[int]().Filter(func(int) bool { t.Error("predicate called on None"); return true }).IsNone() {
+		t.Error("Expected None to stay None")
+	}
+}
+
+func TestOrAndOrElse(t *testing.T) {
+	some := option.SomeOption(1)
+	other := option.SomeOption(2)
+	none := option.NoneOption[int]()
+
+	t.Run("Or", func(t *testing.T) {
+		if some.Or(other).Unwrap() != 1 {
+			t.Error("Expected Some to win")
+		}
+		if none.Or(other).Unwrap() != 2 {
+			t.Error("Expected fallback for None")
+		}
+		if !none.Or(none).IsNone() {
+			t.Error("Expected None when both are None")
+		}
+	})
+
+	t.Run("OrElse is lazy", func(t *testing.T) {
+		called := false
+		fallback := func() option.Option[int] { called = true; return other }
+		if some.OrElse(fallback).Unwrap() != 1 || called {
+			t.Error("Expected fallback not to be called for Some")
+		}
+		if none.OrElse(fallback).Unwrap() != 2 || !called {
+			t.Error("Expected fallback to be called for None")
+		}
+	})
+}
+
+func TestXor(t *testing.T) {
+	some := option.SomeOption(1)
+	other := option.SomeOption(2)
+	none := option.NoneOption[int]()
+
+	if some.Xor(none).Unwrap() != 1 {
+		t.Error("Expected Some(1) for Some xor None")
+	}
+	if none.Xor(other).Unwrap() != 2 {
+		t.Error("Expected Some(2) for None xor Some")
+	}
+	if !some.Xor(other).IsNone() {
+		t.Error("Expected None for Some xor Some")
+	}
+	if !none.Xor(none).IsNone() {
+		t.Error("Expected None for None xor None")
+	}
+}
+
+func TestInspect(t *testing.T) {
+	seen := []int{}
+	record := func(v int) { seen = append(seen, v) }
+
+	opt := option.SomeOption(7).Inspect(record)
+	option.NoneOption[int]().Inspect(record)
+
+	if opt.Unwrap() != 7 {
+		t.Error("Expected Inspect to return the original option")
+	}
+	if len(seen) != 1 || seen[0] != 7 {
+		t.Errorf("Expected [7], got %v", seen)
+	}
+}
+
+func TestMap(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	if option.Map(option.SomeOption("abc"), length).Unwrap() != 3 {
+		t.Error("Expected Some(3)")
+	}
+	if !option.Map(option.NoneOption[string](), length).IsNone() {
+		t.Error("Expected None")
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	half := func(v int) option.Option[int] {
+		if v%2 != 0 {
+			return option.NoneOption[int]()
+		}
+		return option.SomeOption(v / 2)
+	}
+
+	t.Run("chains Some", func(t *testing.T) {
+		got := option.AndThen(option.AndThen(option.SomeOption(8), half), half)
+		if got.Unwrap() != 2 {
+			t.Errorf("Expected 2, got %v", got.Unwrap())
+		}
+	})
+
+	t.Run("short circuits on None", func(t *testing.T) {
+		if !option.AndThen(option.SomeOption(3), half).IsNone() {
+			t.Error("Expected None for odd input")
+		}
+		if !option.FlatMap(option.NoneOption[int](), half).IsNone() {
+			t.Error("Expected None to stay None")
+		}
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	if option.Flatten(option.SomeOption(option.SomeOption(1))).Unwrap() != 1 {
+		t.Error("Expected Some(1)")
+	}
+	if !option.Flatten(option.SomeOption(option.NoneOption[int]())).IsNone() {
+		t.Error("Expected None for Some(None)")
+	}
+	if !option.Flatten(option.NoneOption[option.Option[int]]()).IsNone() {
+		t.Error("Expected None for None")
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	t.Run("zips two Somes", func(t *testing.T) {
+		zipped := option.Zip(option.SomeOption(1), option.SomeOption("a"))
+		pair := zipped.Unwrap()
+		if pair.First != 1 || pair.Second != "a" {
+			t.Errorf("Expected {1 a}, got %v", pair)
+		}
+
+		a, b := option.Unzip(zipped)
+		if a.Unwrap() != 1 || b.Unwrap() != "a" {
+			t.Error("Expected Unzip to reverse Zip")
+		}
+	})
+
+	t.Run("None on either side gives None", func(t *testing.T) {
+		if !option.Zip(option.NoneOption[int](), option.SomeOption("a")).IsNone() {
+			t.Error("Expected None")
+		}
+		if !option.Zip(option.SomeOption(1), option.NoneOption[string]()).IsNone() {
+			t.Error("Expected None")
+		}
+
+		a, b := option.Unzip(option.NoneOption[option.Pair[int, string]]())
+		if !a.IsNone() || !b.IsNone() {
+			t.Error("Expected both halves to be None")
+		}
+	})
+}
+
+var sinkOption option.Option[int]
+
+func BenchmarkNewInfer(b *testing.B) {
+	b.ReportAllocs()
+	for i := range b.N {
+		sinkOption = option.NewInfer(i)
+	}
+}
+
+func BenchmarkNewInferStruct(b *testing.B) {
+	type point struct{ X, Y int }
+	b.ReportAllocs()
+	var opt option.Option[point]
+	for i := range b.N {
+		opt = option.NewInfer(point{X: i})
+	}
+	_ = opt
+}
+
+func BenchmarkNewInferFloat64(b *testing.B) {
+	b.ReportAllocs()
+	var opt option.Option[float64]
+	for i := range b.N {
+		opt = option.NewInfer(float64(i))
+	}
+	_ = opt
+}
+
+func BenchmarkNewInferUint8(b *testing.B) {
+	b.ReportAllocs()
+	var opt option.Option[uint8]
+	for i := range b.N {
+		opt = option.NewInfer(uint8(i))
+	}
+	_ = opt
+}
+
+func BenchmarkFromComparable(b *testing.B) {
+	b.ReportAllocs()
+	for i := range b.N {
+		sinkOption = option.FromComparable(i)
+	}
+}
+
+func BenchmarkFromComparableFloat64(b *testing.B) {
+	b.ReportAllocs()
+	var opt option.Option[float64]
+	for i := range b.N {
+		opt = option.FromComparable(float64(i))
+	}
+	_ = opt
+}
+
+func TestPtrConversions(t *testing.T) {
+	t.Run("FromPtr", func(t *testing.T) {
+		v := 3
+		if option.FromPtr(&v).Unwrap() != 3 {
+			t.Error("Expected Some(3)")
+		}
+		if !option.FromPtr[int](nil).IsNone() {
+			t.Error("Expected None for nil pointer")
+		}
+	})
+
+	t.Run("ToPtr", func(t *testing.T) {
+		opt := option.SomeOption(3)
+		ptr := opt.ToPtr()
+		if ptr == nil || *ptr != 3 {
+			t.Fatal("Expected pointer to 3")
+		}
+		*ptr = 4
+		if opt.Unwrap() != 3 {
+			t.Error("Expected ToPtr to return a copy")
+		}
+		if option.NoneOption[int]().ToPtr() != nil {
+			t.Error("Expected nil for None")
+		}
+	})
+}
+
+func TestTake(t *testing.T) {
+	opt := option.SomeOption(1)
+	taken := opt.Take()
+	if taken.Unwrap() != 1 {
+		t.Error("Expected taken value to be Some(1)")
+	}
+	if !opt.IsNone() {
+		t.Error("Expected option to be None after Take")
+	}
+	if !opt.Take().IsNone() {
+		t.Error("Expected Take on None to return None")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	opt := option.NoneOption[int]()
+	if !opt.Replace(1).IsNone() {
+		t.Error("Expected old value None")
+	}
+	if opt.Replace(2).Unwrap() != 1 {
+		t.Error("Expected old value Some(1)")
+	}
+	if opt.Unwrap() != 2 {
+		t.Error("Expected option to hold 2")
+	}
+}
+
+func TestInsert(t *testing.T) {
+	opt := option.SomeOption(1)
+	ptr := opt.Insert(5)
+	if *ptr != 5 || opt.Unwrap() != 5 {
+		t.Error("Expected option to hold 5")
+	}
+	*ptr = 6
+	if opt.Unwrap() != 6 {
+		t.Error("Expected pointer to alias the stored value")
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	t.Run("inserts into None", func(t *testing.T) {
+		var opt option.Option[int]
+		ptr := opt.GetOrInsert(3)
+		if *ptr != 3 || opt.Unwrap() != 3 {
+			t.Error("Expected option to hold 3")
+		}
+		*ptr++
+		if opt.Unwrap() != 4 {
+			t.Error("Expected pointer to alias the stored value")
+		}
+	})
+
+	t.Run("keeps existing Some", func(t *testing.T) {
+		opt := option.SomeOption(1)
+		if *opt.GetOrInsert(3) != 1 {
+			t.Error("Expected existing value to be kept")
+		}
+	})
+
+	t.Run("GetOrInsertWith is lazy", func(t *testing.T) {
+		calls := 0
+		f := func() int { calls++; return 9 }
+
+		var opt option.Option[int]
+		if *opt.GetOrInsertWith(f) != 9 {
+			t.Error("Expected 9")
+		}
+		if *opt.GetOrInsertWith(f) != 9 {
+			t.Error("Expected 9")
+		}
+		if calls != 1 {
+			t.Errorf("Expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("works as a struct field", func(t *testing.T) {
+		type cache struct{ hits option.Option[[]string] }
+		var c cache
+		*c.hits.GetOrInsert(nil) = append(*c.hits.GetOrInsert(nil), "a")
+		if len(c.hits.Unwrap()) != 1 {
+			t.Error("Expected the field to be updated in place")
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package option
+
+import "iter"
+
+// All yields the value of a Some and nothing for a None.
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.IsSome() {
+			yield(o.val)
+		}
+	}
+}
+
+// FromSeq returns the first element of seq, or None if it is empty.
+func FromSeq[T any](seq iter.Seq[T]) Option[T] {
+	for val := range seq {
+		return SomeOption(val)
+	}
+	return NoneOption[T]()
+}
+
+// Somes yields the values of the Some elements of seq, skipping the Nones.
+func Somes[T any](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsSome() && !yield(o.val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package option_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+func TestAll(t *testing.T) {
+	if got := slices.Collect(option.SomeOption(1).All()); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected [1], got %v", got)
+	}
+	if got := slices.Collect(option.NoneOption[int]().All()); len(got) != 0 {
+		t.Errorf("Expected empty sequence, got %v", got)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("takes the first element", func(t *testing.T) {
+		pulled := 0
+		seq := func(yield func(int) bool) {
+			for _, v := range []int{4, 5, 6} {
+				pulled++
+				if !yield(v) {
+					return
+				}
+			}
+		}
+		if option.FromSeq(seq).Unwrap() != 4 {
+			t.Error("Expected Some(4)")
+		}
+		if pulled != 1 {
+			t.Errorf("Expected only one element pulled, got %d", pulled)
+		}
+	})
+
+	t.Run("empty sequence is None", func(t *testing.T) {
+		if !option.FromSeq(slices.Values([]int{})).IsNone() {
+			t.Error("Expected None")
+		}
+	})
+}
+
+func TestSomes(t *testing.T) {
+	opts := []option.Option[int]{
+		option.SomeOption(1),
+		option.NoneOption[int](),
+		option.SomeOption(0),
+		option.NoneOption[int](),
+		option.SomeOption(3),
+	}
+
+	t.Run("keeps Some values in order", func(t *testing.T) {
+		got := slices.Collect(option.Somes(slices.Values(opts)))
+		if !slices.Equal(got, []int{1, 0, 3}) {
+			t.Errorf("Expected [1 0 3], got %v", got)
+		}
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		got := []int{}
+		for v := range option.Somes(slices.Values(opts)) {
+			got = append(got, v)
+			break
+		}
+		if !slices.Equal(got, []int{1}) {
+			t.Errorf("Expected [1], got %v", got)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner. NULL becomes None, any other value goes through
+// the same conversion database/sql applies when scanning into a *T.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = NoneOption[T]()
+		return nil
+	}
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = SomeOption(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer. None becomes NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	if o.IsNone() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.val)
+}
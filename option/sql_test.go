@@ -0,0 +1,157 @@
+package option_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+// fakeDriver serves a single row of preset values and records Exec arguments.
+type fakeDriver struct {
+	row  []driver.Value
+	args []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.args = args
+	return driver.RowsAffected(1), nil
+}
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{row: s.d.row}, nil
+}
+
+type fakeRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *fakeRows) Columns() []string {
+	cols := make([]string, len(r.row))
+	for i := range cols {
+		cols[i] = "c"
+	}
+	return cols
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+var fakeDriverCount int
+
+func openFakeDB(t *testing.T, row ...driver.Value) (*sql.DB, *fakeDriver) {
+	t.Helper()
+	d := &fakeDriver{row: row}
+	fakeDriverCount++
+	name := fmt.Sprintf("option-fake-%d", fakeDriverCount)
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, d
+}
+
+func TestScan(t *testing.T) {
+	t.Run("NULL scans as None", func(t *testing.T) {
+		db, _ := openFakeDB(t, nil)
+		opt := option.SomeOption("stale")
+		if err := db.QueryRow("SELECT").Scan(&opt); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !opt.IsNone() {
+			t.Error("Expected None for NULL column")
+		}
+	})
+
+	t.Run("values scan as Some with driver conversion", func(t *testing.T) {
+		db, _ := openFakeDB(t, []byte("hello"), int64(42), "3.5", int64(0))
+		var s option.Option[string]
+		var i option.Option[int]
+		var f option.Option[float64]
+		var b option.Option[bool]
+		if err := db.QueryRow("SELECT").Scan(&s, &i, &f, &b); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if s.Unwrap() != "hello" {
+			t.Errorf("Expected 'hello', got %v", s.Unwrap())
+		}
+		if i.Unwrap() != 42 {
+			t.Errorf("Expected 42, got %v", i.Unwrap())
+		}
+		if f.Unwrap() != 3.5 {
+			t.Errorf("Expected 3.5, got %v", f.Unwrap())
+		}
+		if !b.IsSome() || b.Unwrap() != false {
+			t.Error("Expected Some(false)")
+		}
+	})
+
+	t.Run("unsupported type returns error", func(t *testing.T) {
+		var opt option.Option[struct{ X int }]
+		if err := opt.Scan(int64(1)); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+		if !opt.IsNone() {
+			t.Error("Expected option to stay None on error")
+		}
+	})
+}
+
+func TestValue(t *testing.T) {
+	t.Run("None is NULL", func(t *testing.T) {
+		v, err := option.NoneOption[int]().Value()
+		if err != nil || v != nil {
+			t.Errorf("Expected nil value, got %v, %v", v, err)
+		}
+	})
+
+	t.Run("Some is converted to a driver value", func(t *testing.T) {
+		v, err := option.SomeOption(int32(5)).Value()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v != int64(5) {
+			t.Errorf("Expected int64(5), got %#v", v)
+		}
+	})
+
+	t.Run("unsupported type returns error", func(t *testing.T) {
+		if _, err := option.SomeOption(struct{ X int }{1}).Value(); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+	})
+
+	t.Run("Exec passes options as arguments", func(t *testing.T) {
+		db, d := openFakeDB(t)
+		if _, err := db.Exec("UPDATE", option.SomeOption("x"), option.NoneOption[int]()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(d.args) != 2 || d.args[0] != "x" || d.args[1] != nil {
+			t.Errorf("Expected [x <nil>], got %v", d.args)
+		}
+	})
+}
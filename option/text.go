@@ -0,0 +1,172 @@
+package option
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MarshalText encodes None as empty text and Some(v) as the text form of v.
+// Supported payloads are strings, integers, floats, bools, time.Duration and
+// any type implementing encoding.TextMarshaler.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if o.IsNone() {
+		return []byte{}, nil
+	}
+	s, err := formatText(o.val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText decodes empty text as None and anything else as Some.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = NoneOption[T]()
+		return nil
+	}
+	return o.Set(string(text))
+}
+
+// Set parses s as for a flag, so flag.Var(Flag(&opt), ...) leaves opt None until the flag is passed.
+// Unlike UnmarshalText an empty argument is Some of the parsed empty value.
+func (o *Option[T]) Set(s string) error {
+	val, err := parseText[T](s)
+	if err != nil {
+		return err
+	}
+	*o = SomeOption(val)
+	return nil
+}
+
+// IsBoolFlag lets an Option[bool] flag be passed without a value, as in -verbose.
+func (o *Option[T]) IsBoolFlag() bool {
+	var zeroValue T
+	_, ok := any(zeroValue).(bool)
+	return ok
+}
+
+type flagValue[T any] struct {
+	opt *Option[T]
+}
+
+// Flag adapts o for flag.Var. Its String is the text form of the value, so flag
+// help shows a Some(8080) default as "8080" and no default for None.
+func Flag[T any](o *Option[T]) flag.Value {
+	return &flagValue[T]{opt: o}
+}
+
+func (f *flagValue[T]) String() string {
+	if f.opt == nil {
+		return ""
+	}
+	text, err := f.opt.MarshalText()
+	if err != nil {
+		return fmt.Sprint(f.opt.val)
+	}
+	return string(text)
+}
+
+func (f *flagValue[T]) Set(s string) error {
+	return f.opt.Set(s)
+}
+
+func (f *flagValue[T]) IsBoolFlag() bool {
+	return f.opt.IsBoolFlag()
+}
+
+func formatText[T any](val T) (string, error) {
+	switch v := any(val).(type) {
+	case encoding.TextMarshaler:
+		b, err := v.MarshalText()
+		return string(b), err
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	}
+	return "", fmt.Errorf("option: unsupported text type %T", val)
+}
+
+func parseText[T any](s string) (T, error) {
+	var val T
+	var err error
+	switch p := any(&val).(type) {
+	case encoding.TextUnmarshaler:
+		err = p.UnmarshalText([]byte(s))
+	case *string:
+		*p = s
+	case *bool:
+		*p, err = strconv.ParseBool(s)
+	case *time.Duration:
+		*p, err = time.ParseDuration(s)
+	case *int:
+		*p, err = parseInt[int](s, strconv.IntSize)
+	case *int8:
+		*p, err = parseInt[int8](s, 8)
+	case *int16:
+		*p, err = parseInt[int16](s, 16)
+	case *int32:
+		*p, err = parseInt[int32](s, 32)
+	case *int64:
+		*p, err = parseInt[int64](s, 64)
+	case *uint:
+		*p, err = parseUint[uint](s, strconv.IntSize)
+	case *uint8:
+		*p, err = parseUint[uint8](s, 8)
+	case *uint16:
+		*p, err = parseUint[uint16](s, 16)
+	case *uint32:
+		*p, err = parseUint[uint32](s, 32)
+	case *uint64:
+		*p, err = parseUint[uint64](s, 64)
+	case *float32:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		*p = float32(f)
+	case *float64:
+		*p, err = strconv.ParseFloat(s, 64)
+	default:
+		err = fmt.Errorf("option: unsupported text type %T", val)
+	}
+	return val, err
+}
+
+func parseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](s string, bits int) (T, error) {
+	n, err := strconv.ParseInt(s, 0, bits)
+	return T(n), err
+}
+
+func parseUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](s string, bits int) (T, error) {
+	n, err := strconv.ParseUint(s, 0, bits)
+	return T(n), err
+}
@@ -0,0 +1,163 @@
+package option_test
+
+import (
+	"flag"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Robert-Safin/go-extra-types/option"
+)
+
+func TestMarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		marshal  func() ([]byte, error)
+		expected string
+	}{
+		{"string", option.SomeOption("hi").MarshalText, "hi"},
+		{"int", option.SomeOption(-12).MarshalText, "-12"},
+		{"uint8", option.SomeOption(uint8(200)).MarshalText, "200"},
+		{"float", option.SomeOption(1.5).MarshalText, "1.5"},
+		{"bool", option.SomeOption(false).MarshalText, "false"},
+		{"duration", option.SomeOption(90 * time.Second).MarshalText, "1m30s"},
+		{"text marshaler", option.SomeOption(net.IPv4(10, 0, 0, 1)).MarshalText, "10.0.0.1"},
+		{"None", option.NoneOption[int]().MarshalText, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.marshal()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(got) != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+
+	t.Run("unsupported type returns error", func(t *testing.T) {
+		if _, err := option.SomeOption([]int{1}).MarshalText(); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+	})
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Run("parses supported types", func(t *testing.T) {
+		var i option.Option[int]
+		var u option.Option[uint16]
+		var f option.Option[float32]
+		var b option.Option[bool]
+		var d option.Option[time.Duration]
+		var ip option.Option[net.IP]
+
+		for _, step := range []struct {
+			opt  interface{ UnmarshalText([]byte) error }
+			text string
+		}{{&i, "42"}, {&u, "0x10"}, {&f, "2.5"}, {&b, "true"}, {&d, "1h"}, {&ip, "127.0.0.1"}} {
+			if err := step.opt.UnmarshalText([]byte(step.text)); err != nil {
+				t.Fatalf("Unexpected error for %q: %v", step.text, err)
+			}
+		}
+
+		if i.Unwrap() != 42 || u.Unwrap() != 16 || f.Unwrap() != 2.5 || !b.Unwrap() || d.Unwrap() != time.Hour {
+			t.Errorf("Unexpected values: %v %v %v %v %v", i.Unwrap(), u.Unwrap(), f.Unwrap(), b.Unwrap(), d.Unwrap())
+		}
+		if !ip.Unwrap().Equal(net.IPv4(127, 0, 0, 1)) {
+			t.Errorf("Expected 127.0.0.1, got %v", ip.Unwrap())
+		}
+	})
+
+	t.Run("empty text is None", func(t *testing.T) {
+		opt := option.SomeOption(3)
+		if err := opt.UnmarshalText(nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !opt.IsNone() {
+			t.Error("Expected None for empty text")
+		}
+	})
+
+	t.Run("invalid input returns error", func(t *testing.T) {
+		var opt option.Option[int8]
+		if err := opt.UnmarshalText([]byte("300")); err == nil {
+			t.Error("Expected range error")
+		}
+		if !opt.IsNone() {
+			t.Error("Expected option to stay None on error")
+		}
+	})
+
+	t.Run("unsupported type returns error", func(t *testing.T) {
+		var opt option.Option[[]int]
+		if err := opt.UnmarshalText([]byte("1")); err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+	})
+}
+
+func TestFlagValue(t *testing.T) {
+	newFlagSet := func() (*flag.FlagSet, *option.Option[int], *option.Option[string], *option.Option[bool]) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		var port option.Option[int]
+		var name option.Option[string]
+		var verbose option.Option[bool]
+		fs.Var(option.Flag(&port), "port", "")
+		fs.Var(option.Flag(&name), "name", "")
+		fs.Var(option.Flag(&verbose), "verbose", "")
+		return fs, &port, &name, &verbose
+	}
+
+	t.Run("unset flags stay None", func(t *testing.T) {
+		fs, port, name, verbose := newFlagSet()
+		if err := fs.Parse(nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !port.IsNone() || !name.IsNone() || !verbose.IsNone() {
+			t.Error("Expected all flags to be None")
+		}
+	})
+
+	t.Run("set flags become Some, including zero values", func(t *testing.T) {
+		fs, port, name, verbose := newFlagSet()
+		if err := fs.Parse([]string{"-port=0", "-name=", "-verbose"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !port.IsSome() || port.Unwrap() != 0 {
+			t.Error("Expected port to be Some(0)")
+		}
+		if !name.IsSome() || name.Unwrap() != "" {
+			t.Error("Expected name to be Some(\"\")")
+		}
+		if !verbose.IsSome() || !verbose.Unwrap() {
+			t.Error("Expected verbose to be Some(true)")
+		}
+	})
+
+	t.Run("invalid value is a parse error", func(t *testing.T) {
+		fs, _, _, _ := newFlagSet()
+		if err := fs.Parse([]string{"-port=abc"}); err == nil {
+			t.Error("Expected parse error")
+		}
+	})
+
+	t.Run("PrintDefaults shows text defaults", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		var out strings.Builder
+		fs.SetOutput(&out)
+		port := option.SomeOption(8080)
+		var name option.Option[string]
+		fs.Var(option.Flag(&port), "port", "listen `port`")
+		fs.Var(option.Flag(&name), "name", "service name")
+		fs.PrintDefaults()
+
+		expected := "  -name value\n    \tservice name\n  -port port\n    \tlisten port (default 8080)\n"
+		if out.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, out.String())
+		}
+	})
+}
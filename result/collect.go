@@ -0,0 +1,85 @@
+package result
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// Collect returns Ok with every value, or the first Err in rs.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	return CollectSeq(slices.Values(rs))
+}
+
+// CollectSeq is Collect over a sequence. It stops reading at the first Err.
+func CollectSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	vals := []T{}
+	for r := range seq {
+		if r.err != nil {
+			return withErr[[]T](r, r.err)
+		}
+		vals = append(vals, r.val)
+	}
+	return NewOk(vals)
+}
+
+// CollectAll returns Ok with every value, or an Err joining every error in rs.
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	return CollectAllSeq(slices.Values(rs))
+}
+
+func CollectAllSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	vals, errs := PartitionSeq(seq)
+	if len(errs) > 0 {
+		return NewErr[[]T](errors.Join(errs...))
+	}
+	return NewOk(vals)
+}
+
+// Partition splits rs into its values and its errors, keeping their order.
+func Partition[T any](rs []Result[T]) ([]T, []error) {
+	return PartitionSeq(slices.Values(rs))
+}
+
+func PartitionSeq[T any](seq iter.Seq[Result[T]]) ([]T, []error) {
+	vals := []T{}
+	errs := []error{}
+	for r := range seq {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		} else {
+			vals = append(vals, r.val)
+		}
+	}
+	return vals, errs
+}
+
+func Values[T any](rs []Result[T]) []T {
+	return slices.Collect(ValuesSeq(slices.Values(rs)))
+}
+
+// ValuesSeq yields the values of the Ok elements of seq.
+func ValuesSeq[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.err == nil && !yield(r.val) {
+				return
+			}
+		}
+	}
+}
+
+func Errors[T any](rs []Result[T]) []error {
+	return slices.Collect(ErrorsSeq(slices.Values(rs)))
+}
+
+// ErrorsSeq yields the errors of the Err elements of seq.
+func ErrorsSeq[T any](seq iter.Seq[Result[T]]) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for r := range seq {
+			if r.err != nil && !yield(r.err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package result_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+var (
+	errFirst  = errors.New("first")
+	errSecond = errors.New("second")
+)
+
+func mixedResults() []result.Result[int] {
+	return []result.Result[int]{
+		result.NewOk(1),
+		result.NewErr[int](errFirst),
+		result.NewOk(2),
+		result.NewErr[int](errSecond),
+		result.NewOk(3),
+	}
+}
+
+func allOk() []result.Result[int] {
+	return []result.Result[int]{result.NewOk(1), result.NewOk(2), result.NewOk(3)}
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("all Ok", func(t *testing.T) {
+		got := result.Collect(allOk()).Unwrap()
+		if !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", got)
+		}
+	})
+
+	t.Run("returns the first error", func(t *testing.T) {
+		if result.Collect(mixedResults()).Error() != errFirst {
+			t.Error("Expected first error")
+		}
+	})
+
+	t.Run("empty input is Ok", func(t *testing.T) {
+		got := result.Collect[int](nil)
+		if !got.IsOk() || len(got.Unwrap()) != 0 {
+			t.Error("Expected Ok([])")
+		}
+	})
+
+	t.Run("sequence stops at the first error", func(t *testing.T) {
+		pulled := 0
+		seq := func(yield func(result.Result[int]) bool) {
+			for _, r := range mixedResults() {
+				pulled++
+				if !yield(r) {
+					return
+				}
+			}
+		}
+		if result.CollectSeq(seq).Error() != errFirst {
+			t.Error("Expected first error")
+		}
+		if pulled != 2 {
+			t.Errorf("Expected 2 elements pulled, got %d", pulled)
+		}
+	})
+}
+
+func TestCollectAll(t *testing.T) {
+	t.Run("joins every error", func(t *testing.T) {
+		res := result.CollectAll(mixedResults())
+		if !res.IsErrorOf(errFirst) || !res.IsErrorOf(errSecond) {
+			t.Errorf("Expected both errors, got %v", res.Error())
+		}
+		if res.Error().Error() != "first\nsecond" {
+			t.Errorf("Expected errors in order, got %q", res.Error().Error())
+		}
+	})
+
+	t.Run("all Ok", func(t *testing.T) {
+		got := result.CollectAllSeq(slices.Values(allOk())).Unwrap()
+		if !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", got)
+		}
+	})
+}
+
+func TestPartition(t *testing.T) {
+	vals, errs := result.Partition(mixedResults())
+	if !slices.Equal(vals, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", vals)
+	}
+	if !slices.Equal(errs, []error{errFirst, errSecond}) {
+		t.Errorf("Expected [first second], got %v", errs)
+	}
+
+	vals, errs = result.PartitionSeq(slices.Values(allOk()))
+	if len(vals) != 3 || len(errs) != 0 {
+		t.Error("Expected only values")
+	}
+}
+
+func TestValuesAndErrors(t *testing.T) {
+	if got := result.Values(mixedResults()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := result.Errors(mixedResults()); !slices.Equal(got, []error{errFirst, errSecond}) {
+		t.Errorf("Expected [first second], got %v", got)
+	}
+
+	t.Run("sequences are lazy", func(t *testing.T) {
+		for v := range result.ValuesSeq(slices.Values(mixedResults())) {
+			if v != 1 {
+				t.Errorf("Expected 1, got %d", v)
+			}
+			break
+		}
+		for err := range result.ErrorsSeq(slices.Values(mixedResults())) {
+			if err != errFirst {
+				t.Errorf("Expected first error, got %v", err)
+			}
+			break
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package result
+
+// Scope is handed to the body of Do and lets Get abort it.
+// It may only be used on the goroutine running Do, and not after Do returns.
+type Scope struct {
+	_ byte
+}
+
+type abort struct {
+	scope *Scope
+	err   error
+	trace *trace
+}
+
+// Do runs f and returns its value as Ok, or the first error passed to Get as Err.
+// Panics not raised by Get on this scope propagate unchanged.
+func Do[T any](f func(s *Scope) T) (res Result[T]) {
+	s := &Scope{}
+	defer func() {
+		if r := recover(); r != nil {
+			a, ok := r.(abort)
+			if !ok || a.scope != s {
+				panic(r)
+			}
+			res = Result[T]{err: a.err, trace: a.trace}
+		}
+	}()
+	return NewOk(f(s))
+}
+
+// Get unwraps r, or aborts the Do block owning s with the error of r.
+func Get[U any](s *Scope, r Result[U]) U {
+	if r.err != nil {
+		panic(abort{scope: s, err: r.err, trace: r.trace})
+	}
+	return r.val
+}
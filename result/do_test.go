@@ -0,0 +1,92 @@
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func atoi(s string) result.Result[int] {
+	return result.NewInfer(strconv.Atoi(s))
+}
+
+func TestDo(t *testing.T) {
+	t.Run("returns Ok when every Get succeeds", func(t *testing.T) {
+		res := result.Do(func(s *result.Scope) int {
+			a := result.Get(s, atoi("2"))
+			b := result.Get(s, atoi("3"))
+			return a * b
+		})
+		if res.Unwrap() != 6 {
+			t.Errorf("Expected Ok(6), got %v", res)
+		}
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		reached := false
+		res := result.Do(func(s *result.Scope) int {
+			a := result.Get(s, atoi("x"))
+			reached = true
+			return a
+		})
+		var numErr *strconv.NumError
+		if !errors.As(res.Error(), &numErr) {
+			t.Errorf("Expected *strconv.NumError, got %v", res.Error())
+		}
+		if reached {
+			t.Error("Expected block to stop at failing Get")
+		}
+	})
+
+	t.Run("foreign panics pass through unchanged", func(t *testing.T) {
+		sentinel := errors.New("foreign")
+		defer func() {
+			if r := recover(); r != sentinel {
+				t.Errorf("Expected foreign panic value, got %v", r)
+			}
+		}()
+		result.Do(func(s *result.Scope) int { panic(sentinel) })
+		t.Error("Expected Do to re-panic")
+	})
+
+	t.Run("nested blocks abort only their own scope", func(t *testing.T) {
+		inner := errors.New("inner")
+		var innerRes result.Result[int]
+		outer := result.Do(func(outer *result.Scope) int {
+			innerRes = result.Do(func(s *result.Scope) int {
+				return result.Get(s, result.NewErr[int](inner))
+			})
+			return 1
+		})
+		if outer.Unwrap() != 1 || innerRes.Error() != inner {
+			t.Error("Expected inner abort to stay inside the inner block")
+		}
+	})
+
+	t.Run("Get on an outer scope aborts the outer block", func(t *testing.T) {
+		outerErr := errors.New("outer")
+		res := result.Do(func(outer *result.Scope) int {
+			result.Do(func(s *result.Scope) int {
+				return result.Get(outer, result.NewErr[int](outerErr))
+			})
+			t.Error("Expected outer block to be aborted")
+			return 0
+		})
+		if res.Error() != outerErr {
+			t.Errorf("Expected outer error, got %v", res.Error())
+		}
+	})
+
+	t.Run("Try inside Do does not swallow the abort", func(t *testing.T) {
+		err := errors.New("boom")
+		res := result.Do(func(s *result.Scope) int {
+			result.Try(func() int { return result.Get(s, result.NewErr[int](err)) })
+			return 0
+		})
+		if res.Error() != err {
+			t.Errorf("Expected abort to reach Do, got %v", res.Error())
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ResultError carries an Err Result through code that expects a plain error.
+// One built by hand around an Ok Result reports that instead of a message.
+type ResultError[T any] struct {
+	Result Result[T]
+}
+
+func (e *ResultError[T]) Error() string {
+	if e.Result.err == nil {
+		return "result: ResultError holds an Ok Result"
+	}
+	return e.Result.err.Error()
+}
+
+func (e *ResultError[T]) Unwrap() error {
+	return e.Result.err
+}
+
+// Err returns nil for Ok and a *ResultError wrapping the error for Err.
+func (r Result[T]) Err() error {
+	if r.err == nil {
+		return nil
+	}
+	return &ResultError[T]{Result: r}
+}
+
+// IsErrorOf reports whether the error of r matches target as errors.Is does.
+func (r Result[T]) IsErrorOf(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// AsError finds the first error in the chain of r that matches E, as errors.As does.
+func AsError[E error, T any](r Result[T]) (E, bool) {
+	var target E
+	if r.err == nil {
+		return target, false
+	}
+	ok := errors.As(r.err, &target)
+	return target, ok
+}
+
+// Context wraps the error of an Err Result as "msg: err", keeping it unwrappable.
+func Context[T any](r Result[T], msg string) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return withErr[T](r, fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// Wrapf is Context with a formatted message.
+func Wrapf[T any](r Result[T], format string, args ...any) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return withErr[T](r, fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), r.err))
+}
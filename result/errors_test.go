@@ -0,0 +1,99 @@
+package result_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func TestErrAdapter(t *testing.T) {
+	t.Run("Ok gives nil", func(t *testing.T) {
+		if result.NewOk(1).Err() != nil {
+			t.Error("Expected nil error for Ok")
+		}
+	})
+
+	t.Run("Err gives an unwrappable error", func(t *testing.T) {
+		res := result.NewErr[int](fs.ErrNotExist)
+		err := res.Err()
+		if err == nil || err.Error() != fs.ErrNotExist.Error() {
+			t.Fatalf("Expected error message %q, got %v", fs.ErrNotExist, err)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Error("Expected errors.Is to see through the adapter")
+		}
+
+		var re *result.ResultError[int]
+		if !errors.As(err, &re) || !re.Result.IsErr() {
+			t.Error("Expected errors.As to recover the Result")
+		}
+	})
+
+	t.Run("zero value does not panic", func(t *testing.T) {
+		err := &result.ResultError[int]{}
+		if err.Error() != "result: ResultError holds an Ok Result" || err.Unwrap() != nil {
+			t.Errorf("Unexpected zero ResultError: %q, %v", err.Error(), err.Unwrap())
+		}
+	})
+}
+
+func TestIsErrorOf(t *testing.T) {
+	res := result.Context(result.NewErr[int](fs.ErrNotExist), "open config")
+	if !res.IsErrorOf(fs.ErrNotExist) {
+		t.Error("Expected wrapped error to match")
+	}
+	if res.IsErrorOf(fs.ErrPermission) {
+		t.Error("Expected unrelated error not to match")
+	}
+	if result.NewOk(1).IsErrorOf(fs.ErrNotExist) {
+		t.Error("Expected Ok not to match")
+	}
+}
+
+func TestAsError(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "/etc/app", Err: fs.ErrNotExist}
+	res := result.Wrapf(result.NewErr[[]byte](pathErr), "loading %s", "config")
+
+	got, ok := result.AsError[*fs.PathError](res)
+	if !ok || got != pathErr {
+		t.Error("Expected to find *fs.PathError in the chain")
+	}
+
+	if _, ok := result.AsError[*NotFoundError](res); ok {
+		t.Error("Expected no *NotFoundError in the chain")
+	}
+	if _, ok := result.AsError[*fs.PathError](result.NewOk(1)); ok {
+		t.Error("Expected Ok to have no error")
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Run("wraps Err", func(t *testing.T) {
+		base := errors.New("no such key")
+		res := result.Context(result.Context(result.NewErr[string](base), "reading port"), "loading config")
+		if res.Error().Error() != "loading config: reading port: no such key" {
+			t.Errorf("Unexpected message: %v", res.Error())
+		}
+		if !errors.Is(res.Error(), base) {
+			t.Error("Expected the chain to be kept")
+		}
+	})
+
+	t.Run("Wrapf formats the message", func(t *testing.T) {
+		res := result.Wrapf(result.NewErr[int](errors.New("timeout")), "attempt %d of %d", 2, 3)
+		if res.Error().Error() != "attempt 2 of 3: timeout" {
+			t.Errorf("Unexpected message: %v", res.Error())
+		}
+	})
+
+	t.Run("Ok passes through", func(t *testing.T) {
+		if result.Context(result.NewOk(1), "ignored").Unwrap() != 1 {
+			t.Error("Expected Ok to be untouched")
+		}
+		if result.Wrapf(result.NewOk(1), "ignored %d", 1).Unwrap() != 1 {
+			t.Error("Expected Ok to be untouched")
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package result
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// String renders Ok(v) or Err(msg).
+func (r Result[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// Format renders Ok(v) or Err(msg), applying the verb and flags to v or the error.
+// %#v renders the Go syntax that builds the Result, and %+v appends the trace of an Err if one was recorded.
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		if r.IsErr() {
+			fmt.Fprintf(f, "result.NewErr[%v](%#v)", reflect.TypeFor[T](), r.err)
+			return
+		}
+		fmt.Fprintf(f, "result.NewOk[%v](%#v)", reflect.TypeFor[T](), r.val)
+		return
+	}
+	if r.IsErr() {
+		fmt.Fprintf(f, "Err("+fmt.FormatString(f, verb)+")", r.err)
+		if verb == 'v' && f.Flag('+') {
+			for _, frame := range r.Trace() {
+				fmt.Fprintf(f, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+		}
+		return
+	}
+	fmt.Fprintf(f, "Ok("+fmt.FormatString(f, verb)+")", r.val)
+}
+
+// LogValue renders Ok(v) as v and Err as a group holding the error message and type.
+func (r Result[T]) LogValue() slog.Value {
+	if r.IsErr() {
+		return slog.GroupValue(
+			slog.String("error", r.err.Error()),
+			slog.String("type", fmt.Sprintf("%T", r.err)),
+		)
+	}
+	return slog.AnyValue(r.val)
+}
@@ -0,0 +1,69 @@
+package result_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func TestFormat(t *testing.T) {
+	type point struct{ X, Y int }
+	err := errors.New("boom")
+
+	cases := []struct {
+		name     string
+		format   string
+		arg      any
+		expected string
+	}{
+		{"Ok %v", "%v", result.NewOk(42), "Ok(42)"},
+		{"Err %v", "%v", result.NewErr[int](err), "Err(boom)"},
+		{"Ok %q", "%q", result.NewOk("hi"), `Ok("hi")`},
+		{"Err %q", "%q", result.NewErr[int](err), `Err("boom")`},
+		{"Ok %+v", "%+v", result.NewOk(point{1, 2}), "Ok({X:1 Y:2})"},
+		{"Ok %#v", "%#v", result.NewOk(42), "result.NewOk[int](42)"},
+		{"interface Ok %#v", "%#v", result.NewOk[error](nil), "result.NewOk[error](<nil>)"},
+		{"Err %#v", "%#v", result.NewErr[int](err), `result.NewErr[int](&errors.errorString{s:"boom"})`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := fmt.Sprintf(c.format, c.arg); got != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+
+	t.Run("String", func(t *testing.T) {
+		if result.NewOk(1).String() != "Ok(1)" {
+			t.Errorf("Expected Ok(1), got %s", result.NewOk(1).String())
+		}
+		if result.NewErr[int](err).String() != "Err(boom)" {
+			t.Errorf("Expected Err(boom), got %s", result.NewErr[int](err).String())
+		}
+	})
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("job", "ok", result.NewOk(7), "failed", result.NewErr[int](errors.New("boom")))
+
+	got := strings.TrimSpace(buf.String())
+	expected := `{"level":"INFO","msg":"job","ok":7,"failed":{"error":"boom","type":"*errors.errorString"}}`
+	if got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,180 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+var ErrNoFutures = errors.New("result: no futures to wait on")
+
+// Future is a Result that is computed in the background.
+type Future[T any] struct {
+	done   chan struct{}
+	once   sync.Once
+	res    Result[T]
+	cancel context.CancelFunc
+}
+
+// Go runs f in a new goroutine with a context derived from ctx.
+// If ctx is cancelled before f returns, the Future settles as Err(ctx.Err()) straight away;
+// f is still expected to return promptly once its context is done.
+// A panic in f settles the Future as Err holding a *PanicError, and so does a Get on a
+// Scope from a Do outside f, whose error stays reachable through errors.Is and errors.As.
+func Go[T any](ctx context.Context, f func(ctx context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	fut := &Future[T]{done: make(chan struct{}), cancel: cancel}
+	stop := context.AfterFunc(ctx, func() {
+		fut.settle(NewErr[T](ctx.Err()))
+	})
+	go func() {
+		defer cancel()
+		res := runFuture(ctx, f)
+		stop()
+		fut.settle(res)
+	}()
+	return fut
+}
+
+// runFuture calls f and recovers every panic, including an abort from a Scope
+// whose Do runs on another goroutine, since nothing above this goroutine could recover it.
+func runFuture[T any](ctx context.Context, f func(ctx context.Context) (T, error)) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			if a, ok := r.(abort); ok {
+				r = fmt.Errorf("result: Get used a Scope outside the goroutine running its Do: %w", a.err)
+			}
+			res = NewErr[T](&PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+	return NewInfer(f(ctx))
+}
+
+func (f *Future[T]) settle(res Result[T]) {
+	f.once.Do(func() {
+		f.res = res
+		close(f.done)
+	})
+}
+
+// Done is closed once the Future has settled.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context of the work, settling the Future as Err(context.Canceled)
+// unless it has already settled.
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+func (f *Future[T]) Await() Result[T] {
+	<-f.done
+	return f.res
+}
+
+// AwaitTimeout is Await that gives up after d with Err(context.DeadlineExceeded).
+// Giving up does not cancel the work.
+func (f *Future[T]) AwaitTimeout(d time.Duration) Result[T] {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-f.done:
+		return f.res
+	case <-timer.C:
+		return NewErr[T](context.DeadlineExceeded)
+	}
+}
+
+type settled[T any] struct {
+	index int
+	res   Result[T]
+}
+
+// fanIn forwards each Future's Result as it settles. The channel is buffered,
+// so the forwarding goroutines exit as soon as their Future settles.
+func fanIn[T any](futs []*Future[T]) <-chan settled[T] {
+	ch := make(chan settled[T], len(futs))
+	for i, f := range futs {
+		go func() {
+			ch <- settled[T]{index: i, res: f.Await()}
+		}()
+	}
+	return ch
+}
+
+func cancelAll[T any](futs []*Future[T]) {
+	for _, f := range futs {
+		f.Cancel()
+	}
+}
+
+// All settles as Ok with every value in order, or as the first Err to arrive.
+// The remaining futures are cancelled on the first Err.
+func All[T any](futs ...*Future[T]) *Future[[]T] {
+	return Go(context.Background(), func(ctx context.Context) ([]T, error) {
+		vals := make([]T, len(futs))
+		ch := fanIn(futs)
+		for range futs {
+			select {
+			case s := <-ch:
+				if s.res.err != nil {
+					cancelAll(futs)
+					return nil, s.res.err
+				}
+				vals[s.index] = s.res.val
+			case <-ctx.Done():
+				cancelAll(futs)
+				return nil, ctx.Err()
+			}
+		}
+		return vals, nil
+	})
+}
+
+// Any settles as the first Ok to arrive, or as an Err joining every error in order.
+// The remaining futures are cancelled on the first Ok.
+func Any[T any](futs ...*Future[T]) *Future[T] {
+	return Go(context.Background(), func(ctx context.Context) (T, error) {
+		var zeroValue T
+		if len(futs) == 0 {
+			return zeroValue, ErrNoFutures
+		}
+		errs := make([]error, len(futs))
+		ch := fanIn(futs)
+		for range futs {
+			select {
+			case s := <-ch:
+				if s.res.err == nil {
+					cancelAll(futs)
+					return s.res.val, nil
+				}
+				errs[s.index] = s.res.err
+			case <-ctx.Done():
+				cancelAll(futs)
+				return zeroValue, ctx.Err()
+			}
+		}
+		return zeroValue, errors.Join(errs...)
+	})
+}
+
+// Race settles as the first Result to arrive, Ok or Err, and cancels the rest.
+func Race[T any](futs ...*Future[T]) *Future[T] {
+	return Go(context.Background(), func(ctx context.Context) (T, error) {
+		var zeroValue T
+		if len(futs) == 0 {
+			return zeroValue, ErrNoFutures
+		}
+		defer cancelAll(futs)
+		select {
+		case s := <-fanIn(futs):
+			return s.res.Destructure()
+		case <-ctx.Done():
+			return zeroValue, ctx.Err()
+		}
+	})
+}
@@ -0,0 +1,282 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+// checkNoLeak fails the test if goroutines started during it are still running at the end.
+func checkNoLeak(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				t.Errorf("Goroutine leak: %d before, %d after", before, runtime.NumGoroutine())
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+func value[T any](v T) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) { return v, nil }
+}
+
+func failure[T any](err error) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) {
+		var zero T
+		return zero, err
+	}
+}
+
+// blockUntilDone waits for its context and reports the cancellation on stopped.
+func blockUntilDone[T any](stopped chan<- error) func(context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+func TestFutureAwait(t *testing.T) {
+	checkNoLeak(t)
+
+	t.Run("Ok", func(t *testing.T) {
+		if result.Go(context.Background(), value(5)).Await().Unwrap() != 5 {
+			t.Error("Expected Ok(5)")
+		}
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New("boom")
+		if result.Go(context.Background(), failure[int](err)).Await().Error() != err {
+			t.Error("Expected the returned error")
+		}
+	})
+
+	t.Run("panic becomes PanicError", func(t *testing.T) {
+		fut := result.Go(context.Background(), func(context.Context) (int, error) { panic("bad") })
+		if _, ok := result.AsError[*result.PanicError](fut.Await()); !ok {
+			t.Error("Expected *PanicError")
+		}
+	})
+
+	t.Run("Get on an outer Scope becomes PanicError", func(t *testing.T) {
+		err := errors.New("outer")
+		var fut *result.Future[int]
+		outer := result.Do(func(s *result.Scope) int {
+			fut = result.Go(context.Background(), func(context.Context) (int, error) {
+				return result.Get(s, result.NewErr[int](err)), nil
+			})
+			return 1
+		})
+		if outer.Unwrap() != 1 {
+			t.Error("Expected the Do block to be unaffected")
+		}
+
+		res := fut.Await()
+		pe, ok := result.AsError[*result.PanicError](res)
+		if !ok {
+			t.Fatalf("Expected *PanicError, got %v", res.Error())
+		}
+		if !strings.Contains(pe.Error(), "outside the goroutine running its Do") {
+			t.Errorf("Unexpected message: %s", pe.Error())
+		}
+		if !res.IsErrorOf(err) {
+			t.Error("Expected the Get error in the chain")
+		}
+	})
+
+	t.Run("Await is repeatable", func(t *testing.T) {
+		fut := result.Go(context.Background(), value("x"))
+		if fut.Await().Unwrap() != "x" || fut.Await().Unwrap() != "x" {
+			t.Error("Expected the same result twice")
+		}
+		<-fut.Done()
+	})
+}
+
+func TestFutureCancellation(t *testing.T) {
+	checkNoLeak(t)
+
+	t.Run("parent context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan error, 1)
+		fut := result.Go(ctx, blockUntilDone[int](stopped))
+		cancel()
+
+		if !fut.Await().IsErrorOf(context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", fut.Await())
+		}
+		if <-stopped != context.Canceled {
+			t.Error("Expected the work to see the cancellation")
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		stopped := make(chan error, 1)
+		fut := result.Go(context.Background(), blockUntilDone[int](stopped))
+		fut.Cancel()
+		if !fut.Await().IsErrorOf(context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", fut.Await())
+		}
+		<-stopped
+	})
+
+	t.Run("Cancel after settling keeps the result", func(t *testing.T) {
+		fut := result.Go(context.Background(), value(1))
+		fut.Await()
+		fut.Cancel()
+		if fut.Await().Unwrap() != 1 {
+			t.Error("Expected Ok(1)")
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		stopped := make(chan error, 1)
+		fut := result.Go(ctx, blockUntilDone[int](stopped))
+		if !fut.Await().IsErrorOf(context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", fut.Await())
+		}
+		<-stopped
+	})
+}
+
+func TestFutureAwaitTimeout(t *testing.T) {
+	checkNoLeak(t)
+
+	stopped := make(chan error, 1)
+	fut := result.Go(context.Background(), blockUntilDone[int](stopped))
+	if !fut.AwaitTimeout(time.Millisecond).IsErrorOf(context.DeadlineExceeded) {
+		t.Error("Expected timeout error")
+	}
+
+	fut.Cancel()
+	<-stopped
+
+	if result.Go(context.Background(), value(2)).AwaitTimeout(time.Second).Unwrap() != 2 {
+		t.Error("Expected Ok(2) before the timeout")
+	}
+}
+
+func TestAll(t *testing.T) {
+	checkNoLeak(t)
+
+	t.Run("collects values in order", func(t *testing.T) {
+		slow := make(chan struct{})
+		first := result.Go(context.Background(), func(context.Context) (int, error) {
+			<-slow
+			return 1, nil
+		})
+		second := result.Go(context.Background(), value(2))
+		all := result.All(first, second)
+		second.Await()
+		close(slow)
+
+		if got := all.Await().Unwrap(); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", got)
+		}
+	})
+
+	t.Run("first error cancels the rest", func(t *testing.T) {
+		err := errors.New("boom")
+		stopped := make(chan error, 1)
+		pending := result.Go(context.Background(), blockUntilDone[int](stopped))
+		res := result.All(pending, result.Go(context.Background(), failure[int](err))).Await()
+
+		if res.Error() != err {
+			t.Errorf("Expected boom, got %v", res.Error())
+		}
+		if <-stopped != context.Canceled {
+			t.Error("Expected pending future to be cancelled")
+		}
+	})
+
+	t.Run("no futures is Ok", func(t *testing.T) {
+		if got := result.All[int]().Await(); !got.IsOk() || len(got.Unwrap()) != 0 {
+			t.Error("Expected Ok([])")
+		}
+	})
+
+	t.Run("cancelling the combined future cancels the inputs", func(t *testing.T) {
+		stopped := make(chan error, 1)
+		all := result.All(result.Go(context.Background(), blockUntilDone[int](stopped)))
+		all.Cancel()
+		if !all.Await().IsErrorOf(context.Canceled) {
+			t.Error("Expected context.Canceled")
+		}
+		<-stopped
+	})
+}
+
+func TestAny(t *testing.T) {
+	checkNoLeak(t)
+
+	t.Run("first Ok wins and cancels the rest", func(t *testing.T) {
+		stopped := make(chan error, 1)
+		res := result.Any(
+			result.Go(context.Background(), failure[int](errors.New("a"))),
+			result.Go(context.Background(), blockUntilDone[int](stopped)),
+			result.Go(context.Background(), value(3)),
+		).Await()
+
+		if res.Unwrap() != 3 {
+			t.Errorf("Expected Ok(3), got %v", res)
+		}
+		<-stopped
+	})
+
+	t.Run("all errors are joined in order", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		res := result.Any(
+			result.Go(context.Background(), failure[int](errA)),
+			result.Go(context.Background(), failure[int](errB)),
+		).Await()
+		if res.Error().Error() != "a\nb" {
+			t.Errorf("Expected joined errors, got %q", res.Error())
+		}
+	})
+
+	t.Run("no futures", func(t *testing.T) {
+		if !result.Any[int]().Await().IsErrorOf(result.ErrNoFutures) {
+			t.Error("Expected ErrNoFutures")
+		}
+	})
+}
+
+func TestRace(t *testing.T) {
+	checkNoLeak(t)
+
+	t.Run("first to settle wins, even an Err", func(t *testing.T) {
+		err := errors.New("fast failure")
+		stopped := make(chan error, 1)
+		res := result.Race(
+			result.Go(context.Background(), blockUntilDone[int](stopped)),
+			result.Go(context.Background(), failure[int](err)),
+		).Await()
+
+		if res.Error() != err {
+			t.Errorf("Expected fast failure, got %v", res)
+		}
+		<-stopped
+	})
+
+	t.Run("no futures", func(t *testing.T) {
+		if !result.Race[int]().Await().IsErrorOf(result.ErrNoFutures) {
+			t.Error("Expected ErrNoFutures")
+		}
+	})
+}
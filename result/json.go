@@ -0,0 +1,180 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// DecodedError is an error read back from JSON. Err holds the error rebuilt by a
+// registered codec, and is nil when the code was unknown.
+type DecodedError struct {
+	Message string
+	Code    string
+	Err     error
+}
+
+func (e *DecodedError) Error() string {
+	return e.Message
+}
+
+func (e *DecodedError) Unwrap() error {
+	return e.Err
+}
+
+type errorCodec struct {
+	code   string
+	encode func(err error) (any, bool)
+	decode func(data json.RawMessage) (error, error)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   []errorCodec
+)
+
+// RegisterErrorCodecFunc registers how errors travel under code.
+// encode reports whether it handles err and returns the data to send along with the message;
+// decode rebuilds the error from that data. Codecs are tried in registration order,
+// and registering a code again replaces the previous codec.
+func RegisterErrorCodecFunc(code string, encode func(err error) (any, bool), decode func(data json.RawMessage) (error, error)) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codec := errorCodec{code: code, encode: encode, decode: decode}
+	for i, c := range codecs {
+		if c.code == code {
+			codecs[i] = codec
+			return
+		}
+	}
+	codecs = append(codecs, codec)
+}
+
+// RegisterErrorCodec registers E under code, sending it as its own JSON encoding.
+// Any error whose chain contains an E, as errors.As finds it, is encoded this way.
+func RegisterErrorCodec[E error](code string) {
+	RegisterErrorCodecFunc(code,
+		func(err error) (any, bool) {
+			var target E
+			if !errors.As(err, &target) {
+				return nil, false
+			}
+			return target, true
+		},
+		func(data json.RawMessage) (error, error) {
+			var target E
+			if err := json.Unmarshal(data, &target); err != nil {
+				return nil, err
+			}
+			return target, nil
+		},
+	)
+}
+
+func lookupCodec(match func(c errorCodec) bool) (errorCodec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	for _, c := range codecs {
+		if match(c) {
+			return c, true
+		}
+	}
+	return errorCodec{}, false
+}
+
+type okJSON struct {
+	Ok json.RawMessage `json:"ok"`
+}
+
+type errJSON struct {
+	Err  *string         `json:"err"`
+	Code string          `json:"code,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// MarshalJSON encodes Ok(v) as {"ok": v} and Err as {"err": "<message>"}.
+// Errors handled by a registered codec also carry "code" and "data".
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err == nil {
+		val, err := json.Marshal(r.val)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(okJSON{Ok: val})
+	}
+
+	msg := r.err.Error()
+	out := errJSON{Err: &msg}
+	var data any
+	codec, ok := lookupCodec(func(c errorCodec) bool {
+		var handled bool
+		data, handled = c.encode(r.err)
+		return handled
+	})
+	if ok {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		out.Code = codec.code
+		out.Data = raw
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the format written by MarshalJSON. An error with an
+// unregistered code, with none, or whose data the codec cannot rebuild, decodes
+// as a *DecodedError keeping the message.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		okJSON
+		errJSON
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	if wire.Err != nil {
+		codec, ok := lookupCodec(func(c errorCodec) bool { return c.code == wire.Code })
+		if !ok || wire.Code == "" {
+			*r = NewErr[T](&DecodedError{Message: *wire.Err, Code: wire.Code})
+			return nil
+		}
+		decoded, err := codec.decode(wire.Data)
+		if err != nil || isNilError(decoded) {
+			// missing or malformed data still leaves the message to report
+			*r = NewErr[T](&DecodedError{Message: *wire.Err, Code: wire.Code})
+			return nil
+		}
+		if decoded.Error() != *wire.Err {
+			// the typed error was wrapped with extra context; keep the full message
+			decoded = &DecodedError{Message: *wire.Err, Code: wire.Code, Err: decoded}
+		}
+		*r = NewErr[T](decoded)
+		return nil
+	}
+
+	if wire.Ok == nil {
+		return errors.New("result: JSON object has neither \"ok\" nor \"err\"")
+	}
+	var val T
+	if err := json.Unmarshal(wire.Ok, &val); err != nil {
+		return err
+	}
+	*r = NewOk(val)
+	return nil
+}
+
+// isNilError reports whether err is nil or an interface holding a nil pointer, map, slice, func or chan.
+func isNilError(err error) bool {
+	if err == nil {
+		return true
+	}
+	v := reflect.ValueOf(err)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func init() {
+	result.RegisterErrorCodec[*NotFoundError]("not_found")
+	result.RegisterErrorCodecFunc("eof",
+		func(err error) (any, bool) { return nil, errors.Is(err, io.EOF) },
+		func(json.RawMessage) (error, error) { return io.EOF, nil },
+	)
+	result.RegisterErrorCodecFunc("nil_decoder",
+		func(error) (any, bool) { return nil, false },
+		func(json.RawMessage) (error, error) { return nil, nil },
+	)
+}
+
+func TestResultMarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		res      any
+		expected string
+	}{
+		{"Ok", result.NewOk(42), `{"ok":42}`},
+		{"Ok struct", result.NewOk(user{Name: "ann"}), `{"ok":{"Name":"ann"}}`},
+		{"Ok nil pointer", result.NewOk[*int](nil), `{"ok":null}`},
+		{"Err", result.NewErr[int](errors.New("boom")), `{"err":"boom"}`},
+		{"Err with codec", result.NewErr[int](&NotFoundError{ID: 7}), `{"err":"id 7 not found","code":"not_found","data":{"ID":7}}`},
+		{"Err with sentinel codec", result.NewErr[int](io.EOF), `{"err":"EOF","code":"eof","data":null}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := json.Marshal(c.res)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != c.expected {
+				t.Errorf("Expected %s, got %s", c.expected, data)
+			}
+		})
+	}
+}
+
+func TestResultUnmarshalJSON(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		var res result.Result[user]
+		if err := json.Unmarshal([]byte(`{"ok":{"Name":"ann"}}`), &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if res.Unwrap().Name != "ann" {
+			t.Errorf("Expected ann, got %v", res)
+		}
+	})
+
+	t.Run("Ok null", func(t *testing.T) {
+		res := result.NewOk(new(int))
+		if err := json.Unmarshal([]byte(`{"ok":null}`), &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !res.IsOk() || res.Unwrap() != nil {
+			t.Errorf("Expected Ok(nil), got %v", res)
+		}
+	})
+
+	t.Run("unknown error keeps the message", func(t *testing.T) {
+		var res result.Result[int]
+		if err := json.Unmarshal([]byte(`{"err":"disk full","code":"enospc"}`), &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		de, ok := result.AsError[*result.DecodedError](res)
+		if !ok || de.Message != "disk full" || de.Code != "enospc" {
+			t.Errorf("Expected DecodedError, got %#v", res.Error())
+		}
+	})
+
+	t.Run("typed error survives the round trip", func(t *testing.T) {
+		data, _ := json.Marshal(result.NewErr[int](&NotFoundError{ID: 7}))
+		var res result.Result[int]
+		if err := json.Unmarshal(data, &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		nf, ok := res.Error().(*NotFoundError)
+		if !ok || nf.ID != 7 {
+			t.Errorf("Expected *NotFoundError, got %#v", res.Error())
+		}
+	})
+
+	t.Run("wrapped typed error keeps message and type", func(t *testing.T) {
+		wrapped := result.Context(result.NewErr[int](&NotFoundError{ID: 3}), "loading user")
+		data, _ := json.Marshal(wrapped)
+		var res result.Result[int]
+		if err := json.Unmarshal(data, &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if res.Error().Error() != "loading user: id 3 not found" {
+			t.Errorf("Unexpected message: %v", res.Error())
+		}
+		if nf, ok := result.AsError[*NotFoundError](res); !ok || nf.ID != 3 {
+			t.Error("Expected *NotFoundError in the chain")
+		}
+	})
+
+	t.Run("sentinel error survives the round trip", func(t *testing.T) {
+		data, _ := json.Marshal(result.NewErr[int](fmt.Errorf("reading: %w", io.EOF)))
+		var res result.Result[int]
+		if err := json.Unmarshal(data, &res); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !res.IsErrorOf(io.EOF) {
+			t.Errorf("Expected io.EOF, got %#v", res.Error())
+		}
+	})
+
+	t.Run("codec that cannot rebuild the error keeps the message", func(t *testing.T) {
+		payloads := map[string]string{
+			"null data":      `{"err":"gone","code":"not_found","data":null}`,
+			"missing data":   `{"err":"gone","code":"not_found"}`,
+			"malformed data": `{"err":"gone","code":"not_found","data":"bad"}`,
+			"nil from func":  `{"err":"gone","code":"nil_decoder"}`,
+		}
+		for name, payload := range payloads {
+			t.Run(name, func(t *testing.T) {
+				var res result.Result[int]
+				if err := json.Unmarshal([]byte(payload), &res); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				de, ok := result.AsError[*result.DecodedError](res)
+				if !ok || de.Message != "gone" || de.Err != nil {
+					t.Errorf("Expected DecodedError with message gone, got %#v", res.Error())
+				}
+			})
+		}
+	})
+
+	t.Run("invalid payloads", func(t *testing.T) {
+		var res result.Result[int]
+		for _, payload := range []string{`{}`, `[]`, `{"ok":"x"}`} {
+			if err := json.Unmarshal([]byte(payload), &res); err == nil {
+				t.Errorf("Expected error for %s", payload)
+			}
+		}
+	})
+}
@@ -5,14 +5,15 @@ import (
 )
 
 type Result[T any] struct {
-	val T
-	err error
+	val   T
+	err   error
+	trace *trace
 }
 
 func NewInfer[T any](val T, err error) Result[T] {
 	var zeroValue T
 	if err != nil {
-		return Result[T]{val: zeroValue, err: err}
+		return Result[T]{val: zeroValue, err: err, trace: captureTrace(1)}
 	}
 	return Result[T]{val: val, err: nil}
 
@@ -27,8 +28,9 @@ func NewOk[T any](val T) Result[T] {
 func NewErr[T any](err error) Result[T] {
 	var zeroValue T
 	return Result[T]{
-		val: zeroValue,
-		err: err,
+		val:   zeroValue,
+		err:   err,
+		trace: captureTrace(1),
 	}
 }
 
@@ -76,3 +78,66 @@ func (r Result[T]) UnwrapOrFunc(f func(r Result[T]) T) T {
 	}
 	return f(r)
 }
+
+func (r Result[T]) Expect(msg string) T {
+	if r.err == nil {
+		return r.val
+	}
+	panic(fmt.Sprintf("%s: %v", msg, r.err))
+}
+
+func (r Result[T]) MapErr(f func(err error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return withErr[T](r, f(r.err))
+}
+
+func (r Result[T]) Or(other Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return other
+}
+
+func (r Result[T]) OrElse(f func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return f(r.err)
+}
+
+func (r Result[T]) Inspect(f func(val T)) Result[T] {
+	if r.err == nil {
+		f(r.val)
+	}
+	return r
+}
+
+func (r Result[T]) InspectErr(f func(err error)) Result[T] {
+	if r.err != nil {
+		f(r.err)
+	}
+	return r
+}
+
+func Map[T, U any](r Result[T], f func(val T) U) Result[U] {
+	if r.err != nil {
+		return withErr[U](r, r.err)
+	}
+	return NewOk(f(r.val))
+}
+
+func AndThen[T, U any](r Result[T], f func(val T) Result[U]) Result[U] {
+	if r.err != nil {
+		return withErr[U](r, r.err)
+	}
+	return f(r.val)
+}
+
+func And[T, U any](r Result[T], other Result[U]) Result[U] {
+	if r.err != nil {
+		return withErr[U](r, r.err)
+	}
+	return other
+}
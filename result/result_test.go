@@ -2,6 +2,8 @@ package result_test
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/Robert-Safin/go-extra-types/result"
@@ -414,3 +416,139 @@ func TestChainedOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestExpect(t *testing.T) {
+	t.Run("returns value for Ok", func(t *testing.T) {
+		if result.NewOk(5).Expect("loading") != 5 {
+			t.Error("Expected 5")
+		}
+	})
+
+	t.Run("panics with message and error for Err", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "loading: boom" {
+				t.Errorf("Expected panic 'loading: boom', got %v", r)
+			}
+		}()
+		result.NewErr[int](errors.New("boom")).Expect("loading")
+	})
+}
+
+func TestMap(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+
+	if result.Map(result.NewOk(2), double).Unwrap() != 4 {
+		t.Error("Expected Ok(4)")
+	}
+
+	err := errors.New("boom")
+	mapped := result.Map(result.NewErr[int](err), func(v int) string { t.Error("f called on Err"); return "" })
+	if mapped.Error() != err {
+		t.Error("Expected error to be propagated")
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("wrapped: %w", err) }
+
+	if result.NewOk(1).MapErr(wrap).Unwrap() != 1 {
+		t.Error("Expected Ok to be untouched")
+	}
+
+	base := errors.New("boom")
+	res := result.NewErr[int](base).MapErr(wrap)
+	if res.Error().Error() != "wrapped: boom" || !errors.Is(res.Error(), base) {
+		t.Errorf("Expected wrapped error, got %v", res.Error())
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	parse := func(s string) result.Result[int] { return result.NewInfer(strconv.Atoi(s)) }
+	positive := func(v int) result.Result[int] {
+		if v <= 0 {
+			return result.NewErr[int](errors.New("not positive"))
+		}
+		return result.NewOk(v)
+	}
+
+	t.Run("chains Ok", func(t *testing.T) {
+		if result.AndThen(parse("5"), positive).Unwrap() != 5 {
+			t.Error("Expected Ok(5)")
+		}
+	})
+
+	t.Run("short circuits on first error", func(t *testing.T) {
+		called := false
+		res := result.AndThen(parse("x"), func(v int) result.Result[int] { called = true; return positive(v) })
+		if !res.IsErr() || called {
+			t.Error("Expected parse error without calling next step")
+		}
+		if result.AndThen(parse("-1"), positive).Error().Error() != "not positive" {
+			t.Error("Expected second step error")
+		}
+	})
+}
+
+func TestAndOr(t *testing.T) {
+	ok := result.NewOk(1)
+	other := result.NewOk("two")
+	errA := errors.New("a")
+	errB := errors.New("b")
+
+	t.Run("And", func(t *testing.T) {
+		if result.And(ok, other).Unwrap() != "two" {
+			t.Error("Expected second result for Ok")
+		}
+		if result.And(result.NewErr[int](errA), other).Error() != errA {
+			t.Error("Expected first error")
+		}
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		if ok.Or(result.NewOk(2)).Unwrap() != 1 {
+			t.Error("Expected first Ok")
+		}
+		if result.NewErr[int](errA).Or(result.NewOk(2)).Unwrap() != 2 {
+			t.Error("Expected fallback Ok")
+		}
+		if result.NewErr[int](errA).Or(result.NewErr[int](errB)).Error() != errB {
+			t.Error("Expected fallback error")
+		}
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		fallback := func(err error) result.Result[int] {
+			if err == errA {
+				return result.NewOk(0)
+			}
+			return result.NewErr[int](err)
+		}
+		if result.NewErr[int](errA).OrElse(fallback).Unwrap() != 0 {
+			t.Error("Expected recovery from errA")
+		}
+		if result.NewErr[int](errB).OrElse(fallback).Error() != errB {
+			t.Error("Expected errB to pass through")
+		}
+		if ok.OrElse(func(error) result.Result[int] { t.Error("f called on Ok"); return ok }).Unwrap() != 1 {
+			t.Error("Expected Ok to be untouched")
+		}
+	})
+}
+
+func TestInspect(t *testing.T) {
+	vals := []int{}
+	errs := []error{}
+	onVal := func(v int) { vals = append(vals, v) }
+	onErr := func(err error) { errs = append(errs, err) }
+
+	err := errors.New("boom")
+	result.NewOk(1).Inspect(onVal).InspectErr(onErr)
+	result.NewErr[int](err).Inspect(onVal).InspectErr(onErr)
+
+	if len(vals) != 1 || vals[0] != 1 {
+		t.Errorf("Expected [1], got %v", vals)
+	}
+	if len(errs) != 1 || errs[0] != err {
+		t.Errorf("Expected [boom], got %v", errs)
+	}
+}
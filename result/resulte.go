@@ -0,0 +1,179 @@
+package result
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// ResultE is a Result that keeps the concrete type of its error.
+// The zero value is Ok with the zero value of T, like Result.
+type ResultE[T any, E error] struct {
+	val   T
+	err   E
+	isErr bool
+}
+
+// NewInferE is Err when err is not the zero value of E, so a nil *MyError counts as Ok.
+func NewInferE[T any, E error](val T, err E) ResultE[T, E] {
+	if !reflect.ValueOf(&err).Elem().IsZero() {
+		return NewErrE[T](err)
+	}
+	return NewOkE[T, E](val)
+}
+
+func NewOkE[T any, E error](val T) ResultE[T, E] {
+	return ResultE[T, E]{val: val}
+}
+
+func NewErrE[T any, E error](err E) ResultE[T, E] {
+	return ResultE[T, E]{err: err, isErr: true}
+}
+
+// FromResult converts r when its error is exactly of type E, reporting false otherwise.
+func FromResult[T any, E error](r Result[T]) (ResultE[T, E], bool) {
+	if r.err == nil {
+		return NewOkE[T, E](r.val), true
+	}
+	err, ok := r.err.(E)
+	if !ok {
+		return ResultE[T, E]{}, false
+	}
+	return NewErrE[T](err), true
+}
+
+func (r ResultE[T, E]) ToResult() Result[T] {
+	if r.isErr {
+		return NewErr[T](r.err)
+	}
+	return NewOk(r.val)
+}
+
+func (r ResultE[T, E]) IsOk() bool {
+	return !r.isErr
+}
+
+func (r ResultE[T, E]) IsErr() bool {
+	return r.isErr
+}
+
+// Error returns the typed error, or the zero value of E for Ok.
+func (r ResultE[T, E]) Error() E {
+	return r.err
+}
+
+func (r ResultE[T, E]) Destructure() (T, E) {
+	return r.val, r.err
+}
+
+func (r ResultE[T, E]) Unwrap() T {
+	if !r.isErr {
+		return r.val
+	}
+	panic(fmt.Sprintf("Unwrap on error: %v", r.err))
+}
+
+func (r ResultE[T, E]) UnwrapOrDefault(def T) T {
+	if !r.isErr {
+		return r.val
+	}
+	return def
+}
+
+func (r ResultE[T, E]) UnwrapOrZero() T {
+	if !r.isErr {
+		return r.val
+	}
+	var zeroValue T
+	return zeroValue
+}
+
+func (r ResultE[T, E]) UnwrapOrFunc(f func(r ResultE[T, E]) T) T {
+	if !r.isErr {
+		return r.val
+	}
+	return f(r)
+}
+
+func (r ResultE[T, E]) Expect(msg string) T {
+	if !r.isErr {
+		return r.val
+	}
+	panic(fmt.Sprintf("%s: %v", msg, r.err))
+}
+
+func (r ResultE[T, E]) MapErr(f func(err E) E) ResultE[T, E] {
+	if !r.isErr {
+		return r
+	}
+	return NewErrE[T](f(r.err))
+}
+
+func (r ResultE[T, E]) Or(other ResultE[T, E]) ResultE[T, E] {
+	if !r.isErr {
+		return r
+	}
+	return other
+}
+
+func (r ResultE[T, E]) OrElse(f func(err E) ResultE[T, E]) ResultE[T, E] {
+	if !r.isErr {
+		return r
+	}
+	return f(r.err)
+}
+
+func (r ResultE[T, E]) Inspect(f func(val T)) ResultE[T, E] {
+	if !r.isErr {
+		f(r.val)
+	}
+	return r
+}
+
+func (r ResultE[T, E]) InspectErr(f func(err E)) ResultE[T, E] {
+	if r.isErr {
+		f(r.err)
+	}
+	return r
+}
+
+func (r ResultE[T, E]) String() string {
+	return r.ToResult().String()
+}
+
+func (r ResultE[T, E]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		if r.isErr {
+			fmt.Fprintf(f, "result.NewErrE[%v](%#v)", reflect.TypeFor[T](), r.err)
+			return
+		}
+		fmt.Fprintf(f, "result.NewOkE[%v, %v](%#v)", reflect.TypeFor[T](), reflect.TypeFor[E](), r.val)
+		return
+	}
+	r.ToResult().Format(f, verb)
+}
+
+func (r ResultE[T, E]) LogValue() slog.Value {
+	return r.ToResult().LogValue()
+}
+
+func MapE[T, U any, E error](r ResultE[T, E], f func(val T) U) ResultE[U, E] {
+	if r.isErr {
+		return NewErrE[U](r.err)
+	}
+	return NewOkE[U, E](f(r.val))
+}
+
+func AndThenE[T, U any, E error](r ResultE[T, E], f func(val T) ResultE[U, E]) ResultE[U, E] {
+	if r.isErr {
+		return NewErrE[U](r.err)
+	}
+	return f(r.val)
+}
+
+func AndE[T, U any, E error](r ResultE[T, E], other ResultE[U, E]) ResultE[U, E] {
+	if r.isErr {
+		return NewErrE[U](r.err)
+	}
+	return other
+}
@@ -0,0 +1,214 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+type NotFoundError struct {
+	ID int
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("id %d not found", e.ID)
+}
+
+type user struct {
+	Name string
+}
+
+func findUser(id int) result.ResultE[user, *NotFoundError] {
+	if id != 1 {
+		return result.NewErrE[user](&NotFoundError{ID: id})
+	}
+	return result.NewOkE[user, *NotFoundError](user{Name: "ann"})
+}
+
+func TestResultEConstructors(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		res := findUser(1)
+		if !res.IsOk() || res.IsErr() {
+			t.Error("Expected Ok")
+		}
+		if res.Unwrap().Name != "ann" {
+			t.Errorf("Expected ann, got %v", res.Unwrap())
+		}
+		if res.Error() != nil {
+			t.Error("Expected nil typed error for Ok")
+		}
+	})
+
+	t.Run("Err keeps the concrete type", func(t *testing.T) {
+		res := findUser(2)
+		if !res.IsErr() {
+			t.Fatal("Expected Err")
+		}
+		var err *NotFoundError = res.Error()
+		if err.ID != 2 {
+			t.Errorf("Expected ID 2, got %d", err.ID)
+		}
+	})
+
+	t.Run("zero value is Ok", func(t *testing.T) {
+		var res result.ResultE[int, *NotFoundError]
+		if !res.IsOk() || res.Unwrap() != 0 {
+			t.Error("Expected zero value to be Ok(0)")
+		}
+	})
+
+	t.Run("NewInferE", func(t *testing.T) {
+		if !result.NewInferE[int, *NotFoundError](1, nil).IsOk() {
+			t.Error("Expected Ok for nil pointer error")
+		}
+		res := result.NewInferE(1, &NotFoundError{ID: 3})
+		if !res.IsErr() || res.UnwrapOrZero() != 0 {
+			t.Error("Expected Err with zero value")
+		}
+	})
+}
+
+func TestResultEUnwrap(t *testing.T) {
+	ok := findUser(1)
+	bad := findUser(2)
+
+	if bad.UnwrapOrDefault(user{Name: "guest"}).Name != "guest" {
+		t.Error("Expected default for Err")
+	}
+	if bad.UnwrapOrZero().Name != "" {
+		t.Error("Expected zero for Err")
+	}
+	got := bad.UnwrapOrFunc(func(r result.ResultE[user, *NotFoundError]) user {
+		return user{Name: fmt.Sprint(r.Error().ID)}
+	})
+	if got.Name != "2" {
+		t.Errorf("Expected 2, got %s", got.Name)
+	}
+	if val, err := ok.Destructure(); val.Name != "ann" || err != nil {
+		t.Error("Expected (ann, nil)")
+	}
+
+	defer func() {
+		if r := recover(); r != "lookup: id 2 not found" {
+			t.Errorf("Expected panic 'lookup: id 2 not found', got %v", r)
+		}
+	}()
+	bad.Expect("lookup")
+}
+
+func TestResultECombinators(t *testing.T) {
+	name := func(u user) string { return u.Name }
+
+	t.Run("MapE", func(t *testing.T) {
+		if result.MapE(findUser(1), name).Unwrap() != "ann" {
+			t.Error("Expected Ok(ann)")
+		}
+		if result.MapE(findUser(2), name).Error().ID != 2 {
+			t.Error("Expected typed error to be propagated")
+		}
+	})
+
+	t.Run("AndThenE and AndE", func(t *testing.T) {
+		next := func(u user) result.ResultE[int, *NotFoundError] {
+			return result.NewErrE[int](&NotFoundError{ID: 9})
+		}
+		if result.AndThenE(findUser(1), next).Error().ID != 9 {
+			t.Error("Expected error from second step")
+		}
+		if result.AndThenE(findUser(2), next).Error().ID != 2 {
+			t.Error("Expected error from first step")
+		}
+		if result.AndE(findUser(1), result.NewOkE[int, *NotFoundError](5)).Unwrap() != 5 {
+			t.Error("Expected second result")
+		}
+	})
+
+	t.Run("MapErr, Or and OrElse", func(t *testing.T) {
+		bumped := findUser(2).MapErr(func(e *NotFoundError) *NotFoundError { return &NotFoundError{ID: e.ID + 1} })
+		if bumped.Error().ID != 3 {
+			t.Error("Expected mapped error")
+		}
+		if findUser(2).Or(findUser(1)).Unwrap().Name != "ann" {
+			t.Error("Expected fallback")
+		}
+		recovered := findUser(2).OrElse(func(e *NotFoundError) result.ResultE[user, *NotFoundError] {
+			return result.NewOkE[user, *NotFoundError](user{Name: "guest"})
+		})
+		if recovered.Unwrap().Name != "guest" {
+			t.Error("Expected recovery")
+		}
+	})
+
+	t.Run("Inspect and InspectErr", func(t *testing.T) {
+		var seenVal, seenErr int
+		findUser(1).Inspect(func(user) { seenVal++ }).InspectErr(func(*NotFoundError) { seenErr++ })
+		findUser(2).Inspect(func(user) { seenVal++ }).InspectErr(func(*NotFoundError) { seenErr++ })
+		if seenVal != 1 || seenErr != 1 {
+			t.Errorf("Expected one call each, got %d and %d", seenVal, seenErr)
+		}
+	})
+}
+
+func TestResultEConversions(t *testing.T) {
+	t.Run("ToResult keeps the error", func(t *testing.T) {
+		res := findUser(2).ToResult()
+		var nf *NotFoundError
+		if !errors.As(res.Error(), &nf) || nf.ID != 2 {
+			t.Error("Expected *NotFoundError in Result")
+		}
+		if findUser(1).ToResult().Unwrap().Name != "ann" {
+			t.Error("Expected Ok to convert")
+		}
+	})
+
+	t.Run("FromResult round trips", func(t *testing.T) {
+		orig := findUser(2)
+		back, ok := result.FromResult[user, *NotFoundError](orig.ToResult())
+		if !ok || back.Error() != orig.Error() {
+			t.Error("Expected identical error after round trip")
+		}
+
+		okBack, ok := result.FromResult[user, *NotFoundError](result.NewOk(user{Name: "bo"}))
+		if !ok || okBack.Unwrap().Name != "bo" {
+			t.Error("Expected Ok to convert")
+		}
+	})
+
+	t.Run("FromResult rejects other error types", func(t *testing.T) {
+		_, ok := result.FromResult[user, *NotFoundError](result.NewErr[user](errors.New("other")))
+		if ok {
+			t.Error("Expected conversion to fail")
+		}
+	})
+
+	t.Run("switching on the error type", func(t *testing.T) {
+		describe := func(err error) string {
+			switch e := err.(type) {
+			case *NotFoundError:
+				return fmt.Sprintf("missing %d", e.ID)
+			default:
+				return "other"
+			}
+		}
+		if describe(findUser(4).Error()) != "missing 4" {
+			t.Error("Expected typed switch to match")
+		}
+	})
+}
+
+func TestResultEFormat(t *testing.T) {
+	if got := fmt.Sprint(findUser(2)); got != "Err(id 2 not found)" {
+		t.Errorf("Expected Err(id 2 not found), got %s", got)
+	}
+	if got := fmt.Sprintf("%v", findUser(1)); got != "Ok({ann})" {
+		t.Errorf("Expected Ok({ann}), got %s", got)
+	}
+	if got := fmt.Sprintf("%#v", result.NewOkE[int, *NotFoundError](1)); got != "result.NewOkE[int, *result_test.NotFoundError](1)" {
+		t.Errorf("Unexpected Go syntax: %s", got)
+	}
+	if got := fmt.Sprintf("%#v", result.NewOkE[any, error](nil)); got != "result.NewOkE[interface {}, error](<nil>)" {
+		t.Errorf("Unexpected Go syntax for interface types: %s", got)
+	}
+}
@@ -0,0 +1,158 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff returns how long to wait after the given failed attempt, starting at 1.
+type Backoff func(attempt int) time.Duration
+
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff doubles base after every attempt, never exceeding max.
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < max; i++ {
+			if d > max/2 {
+				return max
+			}
+			d *= 2
+		}
+		return min(d, max)
+	}
+}
+
+// WithJitter scales each delay of b by a random factor in [0, 1).
+// A nil random source uses math/rand/v2.
+func WithJitter(b Backoff, random func() float64) Backoff {
+	if random == nil {
+		random = rand.Float64
+	}
+	return func(attempt int) time.Duration {
+		return time.Duration(float64(b(attempt)) * random())
+	}
+}
+
+// Clock lets tests control time in Retry.
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ErrUnboundedRetry is the Cause of the *RetryError returned for a policy that could retry
+// forever: neither MaxAttempts nor MaxElapsed is set and the context can never be done.
+var ErrUnboundedRetry = errors.New("result: retry policy needs MaxAttempts, MaxElapsed or a cancellable context")
+
+// RetryPolicy configures Retry. Without MaxAttempts and MaxElapsed, Retry keeps going until
+// its context is done, so one of the three must bound it. Other zero fields mean no delay,
+// every error retryable and the real clock.
+type RetryPolicy struct {
+	Backoff     Backoff
+	MaxAttempts int
+	MaxElapsed  time.Duration
+	Retryable   func(err error) bool
+	Clock       Clock
+}
+
+// RetryError records the error of every attempt, and the context error when
+// cancellation stopped the retries, or ErrUnboundedRetry when no attempt was allowed.
+type RetryError struct {
+	Attempts []error
+	Cause    error
+}
+
+func (e *RetryError) Error() string {
+	if len(e.Attempts) == 0 {
+		return fmt.Sprintf("retry stopped before the first attempt: %v", e.Cause)
+	}
+	last := e.Attempts[len(e.Attempts)-1]
+	if e.Cause != nil {
+		return fmt.Sprintf("retry stopped after %d attempts: %v (last error: %v)", len(e.Attempts), e.Cause, last)
+	}
+	return fmt.Sprintf("retry failed after %d attempts: %v", len(e.Attempts), last)
+}
+
+func (e *RetryError) Unwrap() []error {
+	if e.Cause != nil {
+		return append(e.Attempts[:len(e.Attempts):len(e.Attempts)], e.Cause)
+	}
+	return e.Attempts
+}
+
+// Retry calls f until it returns Ok or the policy gives up, in which case the
+// Err holds a *RetryError. Attempts are numbered from 1. A policy without
+// MaxAttempts or MaxElapsed is only accepted with a context that can be done,
+// such as one with a deadline; otherwise f is never called and the Cause is ErrUnboundedRetry.
+func Retry[T any](ctx context.Context, policy RetryPolicy, f func(attempt int) Result[T]) Result[T] {
+	if policy.MaxAttempts <= 0 && policy.MaxElapsed <= 0 && ctx.Done() == nil {
+		return retryErr[T](nil, ErrUnboundedRetry)
+	}
+	clock := policy.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+	start := clock.Now()
+	errs := []error{}
+
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return retryErr[T](errs, err)
+		}
+
+		res := f(attempt)
+		if res.err == nil {
+			return res
+		}
+		errs = append(errs, res.err)
+
+		if policy.Retryable != nil && !policy.Retryable(res.err) {
+			return retryErr[T](errs, nil)
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return retryErr[T](errs, nil)
+		}
+
+		var delay time.Duration
+		if policy.Backoff != nil {
+			delay = policy.Backoff(attempt)
+		}
+		if policy.MaxElapsed > 0 && clock.Now().Add(delay).Sub(start) > policy.MaxElapsed {
+			return retryErr[T](errs, nil)
+		}
+		if delay > 0 {
+			if err := clock.Sleep(ctx, delay); err != nil {
+				return retryErr[T](errs, err)
+			}
+		}
+	}
+}
+
+func retryErr[T any](errs []error, cause error) Result[T] {
+	return NewErr[T](&RetryError{Attempts: errs, Cause: cause})
+}
@@ -0,0 +1,233 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+// fakeClock advances instantly and records every sleep.
+type fakeClock struct {
+	now     time.Time
+	sleeps  []time.Duration
+	onSleep func()
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	if c.onSleep != nil {
+		c.onSleep()
+	}
+	return ctx.Err()
+}
+
+var errTransient = errors.New("transient")
+
+// failTimes fails with errTransient for the first n attempts.
+func failTimes(n int) func(attempt int) result.Result[int] {
+	return func(attempt int) result.Result[int] {
+		if attempt <= n {
+			return result.NewErr[int](errTransient)
+		}
+		return result.NewOk(attempt)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Run("constant", func(t *testing.T) {
+		b := result.ConstantBackoff(time.Second)
+		if b(1) != time.Second || b(10) != time.Second {
+			t.Error("Expected constant delay")
+		}
+	})
+
+	t.Run("exponential with cap", func(t *testing.T) {
+		b := result.ExponentialBackoff(100*time.Millisecond, time.Second)
+		got := []time.Duration{b(1), b(2), b(3), b(4), b(5), b(50)}
+		expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+		if !slices.Equal(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("exponential with a huge cap does not overflow", func(t *testing.T) {
+		b := result.ExponentialBackoff(time.Second, math.MaxInt64)
+		prev := time.Duration(0)
+		for attempt := 1; attempt <= 200; attempt++ {
+			d := b(attempt)
+			if d < prev {
+				t.Fatalf("Expected non-decreasing delays, attempt %d gave %v after %v", attempt, d, prev)
+			}
+			prev = d
+		}
+		if prev != math.MaxInt64 {
+			t.Errorf("Expected delay to reach the cap, got %v", prev)
+		}
+	})
+
+	t.Run("jitter scales the delay", func(t *testing.T) {
+		b := result.WithJitter(result.ConstantBackoff(time.Second), func() float64 { return 0.25 })
+		if b(1) != 250*time.Millisecond {
+			t.Errorf("Expected 250ms, got %v", b(1))
+		}
+
+		random := result.WithJitter(result.ConstantBackoff(time.Second), nil)
+		for range 100 {
+			if d := random(1); d < 0 || d >= time.Second {
+				t.Fatalf("Expected delay in [0, 1s), got %v", d)
+			}
+		}
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("returns the first Ok", func(t *testing.T) {
+		clock := &fakeClock{}
+		policy := result.RetryPolicy{Backoff: result.ExponentialBackoff(time.Second, time.Minute), MaxAttempts: 5, Clock: clock}
+		res := result.Retry(context.Background(), policy, failTimes(2))
+
+		if res.Unwrap() != 3 {
+			t.Errorf("Expected success on attempt 3, got %v", res)
+		}
+		if !slices.Equal(clock.sleeps, []time.Duration{time.Second, 2 * time.Second}) {
+			t.Errorf("Unexpected sleeps: %v", clock.sleeps)
+		}
+	})
+
+	t.Run("stops at MaxAttempts and records every error", func(t *testing.T) {
+		clock := &fakeClock{}
+		policy := result.RetryPolicy{Backoff: result.ConstantBackoff(time.Second), MaxAttempts: 3, Clock: clock}
+		res := result.Retry(context.Background(), policy, failTimes(10))
+
+		re, ok := result.AsError[*result.RetryError](res)
+		if !ok {
+			t.Fatalf("Expected *RetryError, got %v", res.Error())
+		}
+		if len(re.Attempts) != 3 || re.Cause != nil {
+			t.Errorf("Expected 3 attempts and no cause, got %+v", re)
+		}
+		if len(clock.sleeps) != 2 {
+			t.Errorf("Expected 2 sleeps, got %d", len(clock.sleeps))
+		}
+		if !res.IsErrorOf(errTransient) {
+			t.Error("Expected errors.Is to see attempt errors")
+		}
+		if re.Error() != "retry failed after 3 attempts: transient" {
+			t.Errorf("Unexpected message: %s", re.Error())
+		}
+	})
+
+	t.Run("stops before exceeding MaxElapsed", func(t *testing.T) {
+		clock := &fakeClock{}
+		policy := result.RetryPolicy{
+			Backoff:    result.ConstantBackoff(4 * time.Second),
+			MaxElapsed: 10 * time.Second,
+			Clock:      clock,
+		}
+		res := result.Retry(context.Background(), policy, failTimes(10))
+
+		re, _ := result.AsError[*result.RetryError](res)
+		if re == nil || len(re.Attempts) != 3 {
+			t.Fatalf("Expected 3 attempts, got %v", res.Error())
+		}
+		if !slices.Equal(clock.sleeps, []time.Duration{4 * time.Second, 4 * time.Second}) {
+			t.Errorf("Unexpected sleeps: %v", clock.sleeps)
+		}
+	})
+
+	t.Run("non-retryable errors stop immediately", func(t *testing.T) {
+		fatal := errors.New("fatal")
+		clock := &fakeClock{}
+		policy := result.RetryPolicy{
+			Retryable:   func(err error) bool { return !errors.Is(err, fatal) },
+			MaxAttempts: 5,
+			Clock:       clock,
+		}
+		calls := 0
+		res := result.Retry(context.Background(), policy, func(int) result.Result[int] {
+			calls++
+			return result.NewErr[int](fatal)
+		})
+		if calls != 1 || !res.IsErrorOf(fatal) {
+			t.Errorf("Expected one call failing with fatal, got %d calls and %v", calls, res.Error())
+		}
+	})
+
+	t.Run("context cancellation stops the retries", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		clock := &fakeClock{onSleep: cancel}
+		policy := result.RetryPolicy{Backoff: result.ConstantBackoff(time.Second), MaxElapsed: time.Hour, Clock: clock}
+		res := result.Retry(ctx, policy, failTimes(10))
+
+		re, _ := result.AsError[*result.RetryError](res)
+		if re == nil || len(re.Attempts) != 1 || re.Cause != context.Canceled {
+			t.Fatalf("Expected 1 attempt stopped by cancellation, got %v", res.Error())
+		}
+		if !res.IsErrorOf(context.Canceled) || !res.IsErrorOf(errTransient) {
+			t.Error("Expected both the cause and attempt errors in the chain")
+		}
+	})
+
+	t.Run("already cancelled context makes no attempt", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res := result.Retry(ctx, result.RetryPolicy{MaxAttempts: 1, Clock: &fakeClock{}}, func(int) result.Result[int] {
+			t.Error("Expected no attempt")
+			return result.NewOk(0)
+		})
+		re, ok := result.AsError[*result.RetryError](res)
+		if !ok || len(re.Attempts) != 0 || re.Cause != context.Canceled {
+			t.Fatalf("Expected *RetryError caused by cancellation, got %v", res.Error())
+		}
+		if re.Error() != "retry stopped before the first attempt: context canceled" {
+			t.Errorf("Unexpected message: %s", re.Error())
+		}
+	})
+
+	t.Run("unbounded policy is rejected", func(t *testing.T) {
+		res := result.Retry(context.Background(), result.RetryPolicy{}, func(int) result.Result[int] {
+			t.Error("Expected no attempt")
+			return result.NewOk(0)
+		})
+		re, ok := result.AsError[*result.RetryError](res)
+		if !ok || re.Cause != result.ErrUnboundedRetry || !res.IsErrorOf(result.ErrUnboundedRetry) {
+			t.Errorf("Expected *RetryError caused by ErrUnboundedRetry, got %v", res.Error())
+		}
+	})
+
+	t.Run("context deadline bounds a policy without limits", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Hour))
+		defer cancel()
+		clock := &fakeClock{}
+		clock.onSleep = func() {
+			if len(clock.sleeps) == 3 {
+				cancel()
+			}
+		}
+		policy := result.RetryPolicy{Backoff: result.ConstantBackoff(time.Second), Clock: clock}
+		res := result.Retry(ctx, policy, failTimes(100))
+
+		re, ok := result.AsError[*result.RetryError](res)
+		if !ok || len(re.Attempts) != 3 || re.Cause != context.Canceled {
+			t.Fatalf("Expected 3 attempts stopped by the context, got %v", res.Error())
+		}
+	})
+
+	t.Run("real clock with no backoff", func(t *testing.T) {
+		res := result.Retry(context.Background(), result.RetryPolicy{MaxAttempts: 5}, failTimes(1))
+		if res.Unwrap() != 2 {
+			t.Errorf("Expected success on attempt 2, got %v", res)
+		}
+	})
+}
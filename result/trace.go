@@ -0,0 +1,57 @@
+package result
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+const maxTraceDepth = 32
+
+var traceEnabled atomic.Bool
+
+// EnableTrace turns on recording of the caller's frames in NewErr and NewInfer.
+// It is meant for debugging; while disabled no frames are captured.
+func EnableTrace(enabled bool) {
+	traceEnabled.Store(enabled)
+}
+
+func TraceEnabled() bool {
+	return traceEnabled.Load()
+}
+
+type trace struct {
+	pcs []uintptr
+}
+
+// captureTrace records the stack starting skip frames above its caller.
+func captureTrace(skip int) *trace {
+	if !traceEnabled.Load() {
+		return nil
+	}
+	pcs := make([]uintptr, maxTraceDepth)
+	n := runtime.Callers(skip+2, pcs)
+	return &trace{pcs: pcs[:n]}
+}
+
+// Trace returns the frames recorded where the error was created, or nil if
+// tracing was disabled at the time or the Result is Ok.
+func (r Result[T]) Trace() []runtime.Frame {
+	if r.trace == nil {
+		return nil
+	}
+	frames := make([]runtime.Frame, 0, len(r.trace.pcs))
+	callers := runtime.CallersFrames(r.trace.pcs)
+	for {
+		frame, more := callers.Next()
+		frames = append(frames, frame)
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// withErr builds an Err of another type that keeps the trace of r.
+func withErr[U, T any](r Result[T], err error) Result[U] {
+	return Result[U]{err: err, trace: r.trace}
+}
@@ -0,0 +1,97 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func withTrace(t testing.TB) {
+	result.EnableTrace(true)
+	t.Cleanup(func() { result.EnableTrace(false) })
+}
+
+func loadConfig() result.Result[int] {
+	return result.NewErr[int](errors.New("missing config"))
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		if result.TraceEnabled() {
+			t.Fatal("Expected tracing to be disabled")
+		}
+		if loadConfig().Trace() != nil {
+			t.Error("Expected no trace while disabled")
+		}
+	})
+
+	t.Run("records the creating frame", func(t *testing.T) {
+		withTrace(t)
+		frames := loadConfig().Trace()
+		if len(frames) == 0 {
+			t.Fatal("Expected frames")
+		}
+		if !strings.HasSuffix(frames[0].Function, "result_test.loadConfig") {
+			t.Errorf("Expected first frame in loadConfig, got %s", frames[0].Function)
+		}
+	})
+
+	t.Run("NewInfer records only for errors", func(t *testing.T) {
+		withTrace(t)
+		if result.NewInfer(1, nil).Trace() != nil {
+			t.Error("Expected no trace for Ok")
+		}
+		frames := result.NewInfer(0, errors.New("x")).Trace()
+		if len(frames) == 0 || !strings.Contains(frames[0].Function, "TestTrace") {
+			t.Error("Expected trace to start in the test")
+		}
+	})
+
+	t.Run("survives propagation", func(t *testing.T) {
+		withTrace(t)
+		mapped := result.Context(result.Map(loadConfig(), func(v int) string { return "" }), "startup")
+		frames := mapped.Trace()
+		if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "result_test.loadConfig") {
+			t.Error("Expected the original trace after Map and Context")
+		}
+
+		done := result.Do(func(s *result.Scope) int { return result.Get(s, loadConfig()) })
+		if frames := done.Trace(); len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "result_test.loadConfig") {
+			t.Error("Expected the original trace after Do")
+		}
+	})
+
+	t.Run("included in %+v only", func(t *testing.T) {
+		withTrace(t)
+		res := loadConfig()
+		plus := fmt.Sprintf("%+v", res)
+		if !strings.HasPrefix(plus, "Err(missing config)\n") || !strings.Contains(plus, "trace_test.go:") {
+			t.Errorf("Expected trace in %%+v output, got %q", plus)
+		}
+		if fmt.Sprintf("%v", res) != "Err(missing config)" {
+			t.Errorf("Expected %%v to stay on one line")
+		}
+	})
+}
+
+var sinkResult result.Result[int]
+
+func BenchmarkNewErr(b *testing.B) {
+	err := errors.New("boom")
+	b.ReportAllocs()
+	for range b.N {
+		sinkResult = result.NewErr[int](err)
+	}
+}
+
+func BenchmarkNewErrTraced(b *testing.B) {
+	withTrace(b)
+	err := errors.New("boom")
+	b.ReportAllocs()
+	for range b.N {
+		sinkResult = result.NewErr[int](err)
+	}
+}
@@ -0,0 +1,47 @@
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error held by an Err produced from a recovered panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value when it was itself an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Try runs f and turns a panic into an Err holding a *PanicError.
+// An abort raised by Get inside f is passed on to the enclosing Do, so Try must run
+// on the goroutine running that Do; elsewhere the abort is unrecovered and crashes the program.
+func Try[T any](f func() T) Result[T] {
+	return TryErr(func() (T, error) {
+		return f(), nil
+	})
+}
+
+// TryErr is Try for functions that also return an error.
+// Like Try, it passes an abort raised by Get on to the enclosing Do and must run on
+// the goroutine running that Do. Code running f on another goroutine should recover
+// itself, as Go does.
+func TryErr[T any](f func() (T, error)) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			if a, ok := r.(abort); ok {
+				panic(a)
+			}
+			res = NewErr[T](&PanicError{Value: r, Stack: debug.Stack()})
+		}
+	}()
+	return NewInfer(f())
+}
@@ -0,0 +1,72 @@
+package result_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+func TestTry(t *testing.T) {
+	t.Run("returns Ok when f succeeds", func(t *testing.T) {
+		if result.Try(func() int { return 3 }).Unwrap() != 3 {
+			t.Error("Expected Ok(3)")
+		}
+	})
+
+	t.Run("turns a panic into a PanicError", func(t *testing.T) {
+		res := result.Try(func() int {
+			var m map[string]int
+			m["x"] = 1
+			return 0
+		})
+		pe, ok := result.AsError[*result.PanicError](res)
+		if !ok {
+			t.Fatalf("Expected *PanicError, got %v", res.Error())
+		}
+		if !strings.Contains(pe.Error(), "nil map") {
+			t.Errorf("Unexpected message: %s", pe.Error())
+		}
+		if !strings.Contains(string(pe.Stack), "try_test.go") {
+			t.Error("Expected the stack trace to include the panicking frame")
+		}
+	})
+
+	t.Run("keeps non-error panic values", func(t *testing.T) {
+		res := result.Try(func() string { panic(42) })
+		pe, _ := result.AsError[*result.PanicError](res)
+		if pe == nil || pe.Value != 42 {
+			t.Fatalf("Expected panic value 42, got %v", res.Error())
+		}
+		if pe.Unwrap() != nil {
+			t.Error("Expected no wrapped error for non-error value")
+		}
+	})
+}
+
+func TestTryErr(t *testing.T) {
+	t.Run("returns Ok", func(t *testing.T) {
+		if result.TryErr(func() (int, error) { return 1, nil }).Unwrap() != 1 {
+			t.Error("Expected Ok(1)")
+		}
+	})
+
+	t.Run("returns the error", func(t *testing.T) {
+		err := errors.New("boom")
+		if result.TryErr(func() (int, error) { return 1, err }).Error() != err {
+			t.Error("Expected the returned error")
+		}
+	})
+
+	t.Run("unwraps panicked errors", func(t *testing.T) {
+		err := errors.New("boom")
+		res := result.TryErr(func() (int, error) { panic(err) })
+		if !res.IsErrorOf(err) {
+			t.Error("Expected errors.Is to find the panicked error")
+		}
+		if _, ok := result.AsError[*result.PanicError](res); !ok {
+			t.Error("Expected a *PanicError")
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FieldError describes one failed check. Path locates the field, as in "address.zip",
+// and Code is a machine-readable reason such as "required".
+type FieldError struct {
+	Path    string
+	Code    string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validated runs every check on a value and keeps all failures, unlike Result
+// which stops at the first error.
+type Validated[T any] struct {
+	val  T
+	errs []*FieldError
+}
+
+func Validate[T any](val T) *Validated[T] {
+	return &Validated[T]{val: val}
+}
+
+// Check records a FieldError when ok is false.
+func (v *Validated[T]) Check(ok bool, path, code, message string) *Validated[T] {
+	if !ok {
+		v.errs = append(v.errs, &FieldError{Path: path, Code: code, Message: message})
+	}
+	return v
+}
+
+// CheckFunc records the FieldError returned by check, if any.
+func (v *Validated[T]) CheckFunc(check func(val T) *FieldError) *Validated[T] {
+	if err := check(v.val); err != nil {
+		v.errs = append(v.errs, err)
+	}
+	return v
+}
+
+func (v *Validated[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+func (v *Validated[T]) Errors() []*FieldError {
+	return v.errs
+}
+
+// Result is Ok with the value when every check passed, or Err joining every FieldError.
+func (v *Validated[T]) Result() Result[T] {
+	if len(v.errs) == 0 {
+		return NewOk(v.val)
+	}
+	errs := make([]error, len(v.errs))
+	for i, err := range v.errs {
+		errs[i] = err
+	}
+	return NewErr[T](errors.Join(errs...))
+}
+
+// Nest adds the failures of child to v with their paths prefixed by prefix,
+// so a child error at "zip" becomes "address.zip".
+func Nest[T, U any](v *Validated[T], prefix string, child *Validated[U]) *Validated[T] {
+	for _, err := range child.errs {
+		v.errs = append(v.errs, &FieldError{Path: joinPath(prefix, err.Path), Code: err.Code, Message: err.Message})
+	}
+	return v
+}
+
+func joinPath(prefix, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case path[0] == '[':
+		return prefix + path
+	}
+	return prefix + "." + path
+}
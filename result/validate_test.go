@@ -0,0 +1,90 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Robert-Safin/go-extra-types/result"
+)
+
+type address struct {
+	Street string
+	Zip    string
+}
+
+type signup struct {
+	Email   string
+	Age     int
+	Address address
+	Tags    []string
+}
+
+func validateAddress(a address) *result.Validated[address] {
+	return result.Validate(a).
+		Check(a.Street != "", "street", "required", "is required").
+		Check(len(a.Zip) == 5, "zip", "length", "must have 5 digits")
+}
+
+func validateSignup(s signup) *result.Validated[signup] {
+	v := result.Validate(s).
+		Check(strings.Contains(s.Email, "@"), "email", "format", "must be an email address").
+		CheckFunc(func(s signup) *result.FieldError {
+			if s.Age < 18 {
+				return &result.FieldError{Path: "age", Code: "min", Message: "must be at least 18"}
+			}
+			return nil
+		})
+	result.Nest(v, "address", validateAddress(s.Address))
+	for i, tag := range s.Tags {
+		tagCheck := result.Validate(tag).Check(tag != "", "", "required", "must not be empty")
+		result.Nest(v, fmt.Sprintf("tags[%d]", i), tagCheck)
+	}
+	return v
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("valid value converts to Ok", func(t *testing.T) {
+		s := signup{Email: "a@b.c", Age: 30, Address: address{Street: "Main", Zip: "12345"}}
+		v := validateSignup(s)
+		if !v.IsValid() || len(v.Errors()) != 0 {
+			t.Errorf("Expected no errors, got %v", v.Errors())
+		}
+		if v.Result().Unwrap().Email != "a@b.c" {
+			t.Error("Expected Ok with the value")
+		}
+	})
+
+	t.Run("collects every failure with paths", func(t *testing.T) {
+		v := validateSignup(signup{Email: "nope", Age: 12, Address: address{Zip: "1"}, Tags: []string{"go", ""}})
+		if v.IsValid() {
+			t.Fatal("Expected validation to fail")
+		}
+
+		got := []string{}
+		for _, err := range v.Errors() {
+			got = append(got, err.Path+"/"+err.Code)
+		}
+		expected := "email/format age/min address.street/required address.zip/length tags[1]/required"
+		if strings.Join(got, " ") != expected {
+			t.Errorf("Expected %s, got %s", expected, strings.Join(got, " "))
+		}
+	})
+
+	t.Run("Result joins the field errors", func(t *testing.T) {
+		res := validateSignup(signup{Email: "a@b.c", Age: 1, Address: address{Street: "x", Zip: "12345"}}).Result()
+		if res.Error().Error() != "age: must be at least 18" {
+			t.Errorf("Unexpected message: %v", res.Error())
+		}
+
+		res = validateSignup(signup{}).Result()
+		var fieldErr *result.FieldError
+		if !errors.As(res.Error(), &fieldErr) || fieldErr.Path != "email" {
+			t.Errorf("Expected the first field error via errors.As, got %v", fieldErr)
+		}
+		if lines := strings.Split(res.Error().Error(), "\n"); len(lines) != 4 {
+			t.Errorf("Expected 4 joined errors, got %d", len(lines))
+		}
+	})
+}